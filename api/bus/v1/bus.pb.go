@@ -20,16 +20,260 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BusUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *BusUser) Reset() {
+	*x = BusUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bus_v1_bus_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BusUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusUser) ProtoMessage() {}
+
+func (x *BusUser) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bus_v1_bus_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusUser.ProtoReflect.Descriptor instead.
+func (*BusUser) Descriptor() ([]byte, []int) {
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BusUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BusUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BusUser) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *BusUser) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *BusUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type BusRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number string  `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Length float32 `protobuf:"fixed32,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *BusRoute) Reset() {
+	*x = BusRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bus_v1_bus_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BusRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusRoute) ProtoMessage() {}
+
+func (x *BusRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bus_v1_bus_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusRoute.ProtoReflect.Descriptor instead.
+func (*BusRoute) Descriptor() ([]byte, []int) {
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BusRoute) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BusRoute) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *BusRoute) GetLength() float32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type BusInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteId      *uint32   `protobuf:"varint,2,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+	Route        *BusRoute `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	Driver       *BusUser  `protobuf:"bytes,4,opt,name=driver,proto3" json:"driver,omitempty"`
+	Number       string    `protobuf:"bytes,5,opt,name=number,proto3" json:"number,omitempty"`
+	Status       string    `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	BatteryLevel uint32    `protobuf:"varint,7,opt,name=battery_level,json=batteryLevel,proto3" json:"battery_level,omitempty"`
+}
+
+func (x *BusInfo) Reset() {
+	*x = BusInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_bus_v1_bus_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusInfo) ProtoMessage() {}
+
+func (x *BusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_bus_v1_bus_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusInfo.ProtoReflect.Descriptor instead.
+func (*BusInfo) Descriptor() ([]byte, []int) {
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BusInfo) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BusInfo) GetRouteId() uint32 {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return 0
+}
+
+func (x *BusInfo) GetRoute() *BusRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *BusInfo) GetDriver() *BusUser {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *BusInfo) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *BusInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BusInfo) GetBatteryLevel() uint32 {
+	if x != nil {
+		return x.BatteryLevel
+	}
+	return 0
+}
+
 type CreateBusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RouteId *uint32 `protobuf:"varint,1,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+	// не используется: водитель назначается только началом смены
+	//
+	// Deprecated: Do not use.
+	DriverId *string `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	Number   string  `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
 }
 
 func (x *CreateBusRequest) Reset() {
 	*x = CreateBusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[0]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -42,7 +286,7 @@ func (x *CreateBusRequest) String() string {
 func (*CreateBusRequest) ProtoMessage() {}
 
 func (x *CreateBusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[0]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,19 +299,43 @@ func (x *CreateBusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBusRequest.ProtoReflect.Descriptor instead.
 func (*CreateBusRequest) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{0}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateBusRequest) GetRouteId() uint32 {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *CreateBusRequest) GetDriverId() string {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return ""
+}
+
+func (x *CreateBusRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
 }
 
 type CreateBusReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bus *BusInfo `protobuf:"bytes,1,opt,name=bus,proto3" json:"bus,omitempty"`
 }
 
 func (x *CreateBusReply) Reset() {
 	*x = CreateBusReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[1]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -80,7 +348,7 @@ func (x *CreateBusReply) String() string {
 func (*CreateBusReply) ProtoMessage() {}
 
 func (x *CreateBusReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[1]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -93,19 +361,35 @@ func (x *CreateBusReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBusReply.ProtoReflect.Descriptor instead.
 func (*CreateBusReply) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{1}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateBusReply) GetBus() *BusInfo {
+	if x != nil {
+		return x.Bus
+	}
+	return nil
 }
 
 type UpdateBusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id      uint32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RouteId *uint32 `protobuf:"varint,2,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+	// не используется: водитель назначается только началом смены
+	//
+	// Deprecated: Do not use.
+	DriverId *string `protobuf:"bytes,3,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	Number   string  `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	Status   string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (x *UpdateBusRequest) Reset() {
 	*x = UpdateBusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[2]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -118,7 +402,7 @@ func (x *UpdateBusRequest) String() string {
 func (*UpdateBusRequest) ProtoMessage() {}
 
 func (x *UpdateBusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[2]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -131,19 +415,57 @@ func (x *UpdateBusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBusRequest) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{2}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateBusRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBusRequest) GetRouteId() uint32 {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *UpdateBusRequest) GetDriverId() string {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return ""
+}
+
+func (x *UpdateBusRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *UpdateBusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
 type UpdateBusReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bus *BusInfo `protobuf:"bytes,1,opt,name=bus,proto3" json:"bus,omitempty"`
 }
 
 func (x *UpdateBusReply) Reset() {
 	*x = UpdateBusReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[3]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -156,7 +478,7 @@ func (x *UpdateBusReply) String() string {
 func (*UpdateBusReply) ProtoMessage() {}
 
 func (x *UpdateBusReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[3]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,19 +491,28 @@ func (x *UpdateBusReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBusReply.ProtoReflect.Descriptor instead.
 func (*UpdateBusReply) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{3}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateBusReply) GetBus() *BusInfo {
+	if x != nil {
+		return x.Bus
+	}
+	return nil
 }
 
 type DeleteBusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *DeleteBusRequest) Reset() {
 	*x = DeleteBusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[4]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -194,7 +525,7 @@ func (x *DeleteBusRequest) String() string {
 func (*DeleteBusRequest) ProtoMessage() {}
 
 func (x *DeleteBusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[4]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -207,7 +538,14 @@ func (x *DeleteBusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBusRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBusRequest) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{4}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteBusRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
 type DeleteBusReply struct {
@@ -219,7 +557,7 @@ type DeleteBusReply struct {
 func (x *DeleteBusReply) Reset() {
 	*x = DeleteBusReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[5]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -232,7 +570,7 @@ func (x *DeleteBusReply) String() string {
 func (*DeleteBusReply) ProtoMessage() {}
 
 func (x *DeleteBusReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[5]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,19 +583,21 @@ func (x *DeleteBusReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBusReply.ProtoReflect.Descriptor instead.
 func (*DeleteBusReply) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{5}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{8}
 }
 
 type GetBusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *GetBusRequest) Reset() {
 	*x = GetBusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[6]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -270,7 +610,7 @@ func (x *GetBusRequest) String() string {
 func (*GetBusRequest) ProtoMessage() {}
 
 func (x *GetBusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[6]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,19 +623,28 @@ func (x *GetBusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBusRequest.ProtoReflect.Descriptor instead.
 func (*GetBusRequest) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{6}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBusRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
 type GetBusReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bus *BusInfo `protobuf:"bytes,1,opt,name=bus,proto3" json:"bus,omitempty"`
 }
 
 func (x *GetBusReply) Reset() {
 	*x = GetBusReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[7]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -308,7 +657,7 @@ func (x *GetBusReply) String() string {
 func (*GetBusReply) ProtoMessage() {}
 
 func (x *GetBusReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[7]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +670,14 @@ func (x *GetBusReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBusReply.ProtoReflect.Descriptor instead.
 func (*GetBusReply) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{7}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBusReply) GetBus() *BusInfo {
+	if x != nil {
+		return x.Bus
+	}
+	return nil
 }
 
 type ListBusRequest struct {
@@ -333,7 +689,7 @@ type ListBusRequest struct {
 func (x *ListBusRequest) Reset() {
 	*x = ListBusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[8]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -346,7 +702,7 @@ func (x *ListBusRequest) String() string {
 func (*ListBusRequest) ProtoMessage() {}
 
 func (x *ListBusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[8]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,19 +715,22 @@ func (x *ListBusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBusRequest.ProtoReflect.Descriptor instead.
 func (*ListBusRequest) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{8}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{11}
 }
 
 type ListBusReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Buses []*BusInfo `protobuf:"bytes,1,rep,name=buses,proto3" json:"buses,omitempty"`
+	Count int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *ListBusReply) Reset() {
 	*x = ListBusReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_bus_v1_bus_proto_msgTypes[9]
+		mi := &file_api_bus_v1_bus_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -384,7 +743,7 @@ func (x *ListBusReply) String() string {
 func (*ListBusReply) ProtoMessage() {}
 
 func (x *ListBusReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_bus_v1_bus_proto_msgTypes[9]
+	mi := &file_api_bus_v1_bus_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,7 +756,21 @@ func (x *ListBusReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBusReply.ProtoReflect.Descriptor instead.
 func (*ListBusReply) Descriptor() ([]byte, []int) {
-	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{9}
+	return file_api_bus_v1_bus_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListBusReply) GetBuses() []*BusInfo {
+	if x != nil {
+		return x.Buses
+	}
+	return nil
+}
+
+func (x *ListBusReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
 var File_api_bus_v1_bus_proto protoreflect.FileDescriptor
@@ -405,43 +778,103 @@ var File_api_bus_v1_bus_proto protoreflect.FileDescriptor
 var file_api_bus_v1_bus_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e,
-	0x76, 0x31, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12,
-	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x75, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xd9, 0x02, 0x0a, 0x03, 0x42, 0x75, 0x73, 0x12, 0x45,
-	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x42, 0x75, 0x73, 0x12, 0x19, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x3f, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
-	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x42, 0x29, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31,
-	0x50, 0x01, 0x5a, 0x19, 0x62, 0x75, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x62, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x22, 0x87, 0x01, 0x0a, 0x07, 0x42, 0x75, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x4a, 0x0a, 0x08,
+	0x42, 0x75, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0xf4, 0x01, 0x0a, 0x07, 0x42, 0x75, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x2b, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22,
+	0x8b, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x48, 0x01, 0x52, 0x08, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x37, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x25, 0x0a, 0x03, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x03, 0x62, 0x75, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x09, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x48, 0x01, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25,
+	0x0a, 0x03, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x03, 0x62, 0x75, 0x73, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x03, 0x62,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x62,
+	0x75, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x62, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x62, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xd9, 0x02, 0x0a, 0x03, 0x42, 0x75, 0x73, 0x12, 0x45, 0x0a,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75,
+	0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x09, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62,
+	0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x42, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x3f, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x42, 0x29, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x2e, 0x62, 0x75, 0x73, 0x2e, 0x76, 0x31, 0x50,
+	0x01, 0x5a, 0x19, 0x62, 0x75, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x62, 0x75, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -456,35 +889,44 @@ func file_api_bus_v1_bus_proto_rawDescGZIP() []byte {
 	return file_api_bus_v1_bus_proto_rawDescData
 }
 
-var file_api_bus_v1_bus_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_bus_v1_bus_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_bus_v1_bus_proto_goTypes = []interface{}{
-	(*CreateBusRequest)(nil), // 0: api.bus.v1.CreateBusRequest
-	(*CreateBusReply)(nil),   // 1: api.bus.v1.CreateBusReply
-	(*UpdateBusRequest)(nil), // 2: api.bus.v1.UpdateBusRequest
-	(*UpdateBusReply)(nil),   // 3: api.bus.v1.UpdateBusReply
-	(*DeleteBusRequest)(nil), // 4: api.bus.v1.DeleteBusRequest
-	(*DeleteBusReply)(nil),   // 5: api.bus.v1.DeleteBusReply
-	(*GetBusRequest)(nil),    // 6: api.bus.v1.GetBusRequest
-	(*GetBusReply)(nil),      // 7: api.bus.v1.GetBusReply
-	(*ListBusRequest)(nil),   // 8: api.bus.v1.ListBusRequest
-	(*ListBusReply)(nil),     // 9: api.bus.v1.ListBusReply
+	(*BusUser)(nil),          // 0: api.bus.v1.BusUser
+	(*BusRoute)(nil),         // 1: api.bus.v1.BusRoute
+	(*BusInfo)(nil),          // 2: api.bus.v1.BusInfo
+	(*CreateBusRequest)(nil), // 3: api.bus.v1.CreateBusRequest
+	(*CreateBusReply)(nil),   // 4: api.bus.v1.CreateBusReply
+	(*UpdateBusRequest)(nil), // 5: api.bus.v1.UpdateBusRequest
+	(*UpdateBusReply)(nil),   // 6: api.bus.v1.UpdateBusReply
+	(*DeleteBusRequest)(nil), // 7: api.bus.v1.DeleteBusRequest
+	(*DeleteBusReply)(nil),   // 8: api.bus.v1.DeleteBusReply
+	(*GetBusRequest)(nil),    // 9: api.bus.v1.GetBusRequest
+	(*GetBusReply)(nil),      // 10: api.bus.v1.GetBusReply
+	(*ListBusRequest)(nil),   // 11: api.bus.v1.ListBusRequest
+	(*ListBusReply)(nil),     // 12: api.bus.v1.ListBusReply
 }
 var file_api_bus_v1_bus_proto_depIdxs = []int32{
-	0, // 0: api.bus.v1.Bus.CreateBus:input_type -> api.bus.v1.CreateBusRequest
-	2, // 1: api.bus.v1.Bus.UpdateBus:input_type -> api.bus.v1.UpdateBusRequest
-	4, // 2: api.bus.v1.Bus.DeleteBus:input_type -> api.bus.v1.DeleteBusRequest
-	6, // 3: api.bus.v1.Bus.GetBus:input_type -> api.bus.v1.GetBusRequest
-	8, // 4: api.bus.v1.Bus.ListBus:input_type -> api.bus.v1.ListBusRequest
-	1, // 5: api.bus.v1.Bus.CreateBus:output_type -> api.bus.v1.CreateBusReply
-	3, // 6: api.bus.v1.Bus.UpdateBus:output_type -> api.bus.v1.UpdateBusReply
-	5, // 7: api.bus.v1.Bus.DeleteBus:output_type -> api.bus.v1.DeleteBusReply
-	7, // 8: api.bus.v1.Bus.GetBus:output_type -> api.bus.v1.GetBusReply
-	9, // 9: api.bus.v1.Bus.ListBus:output_type -> api.bus.v1.ListBusReply
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1,  // 0: api.bus.v1.BusInfo.route:type_name -> api.bus.v1.BusRoute
+	0,  // 1: api.bus.v1.BusInfo.driver:type_name -> api.bus.v1.BusUser
+	2,  // 2: api.bus.v1.CreateBusReply.bus:type_name -> api.bus.v1.BusInfo
+	2,  // 3: api.bus.v1.UpdateBusReply.bus:type_name -> api.bus.v1.BusInfo
+	2,  // 4: api.bus.v1.GetBusReply.bus:type_name -> api.bus.v1.BusInfo
+	2,  // 5: api.bus.v1.ListBusReply.buses:type_name -> api.bus.v1.BusInfo
+	3,  // 6: api.bus.v1.Bus.CreateBus:input_type -> api.bus.v1.CreateBusRequest
+	5,  // 7: api.bus.v1.Bus.UpdateBus:input_type -> api.bus.v1.UpdateBusRequest
+	7,  // 8: api.bus.v1.Bus.DeleteBus:input_type -> api.bus.v1.DeleteBusRequest
+	9,  // 9: api.bus.v1.Bus.GetBus:input_type -> api.bus.v1.GetBusRequest
+	11, // 10: api.bus.v1.Bus.ListBus:input_type -> api.bus.v1.ListBusRequest
+	4,  // 11: api.bus.v1.Bus.CreateBus:output_type -> api.bus.v1.CreateBusReply
+	6,  // 12: api.bus.v1.Bus.UpdateBus:output_type -> api.bus.v1.UpdateBusReply
+	8,  // 13: api.bus.v1.Bus.DeleteBus:output_type -> api.bus.v1.DeleteBusReply
+	10, // 14: api.bus.v1.Bus.GetBus:output_type -> api.bus.v1.GetBusReply
+	12, // 15: api.bus.v1.Bus.ListBus:output_type -> api.bus.v1.ListBusReply
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_bus_v1_bus_proto_init() }
@@ -494,7 +936,7 @@ func file_api_bus_v1_bus_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_bus_v1_bus_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBusRequest); i {
+			switch v := v.(*BusUser); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -506,7 +948,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBusReply); i {
+			switch v := v.(*BusRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -518,7 +960,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBusRequest); i {
+			switch v := v.(*BusInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -530,7 +972,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBusReply); i {
+			switch v := v.(*CreateBusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -542,7 +984,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBusRequest); i {
+			switch v := v.(*CreateBusReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -554,7 +996,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBusReply); i {
+			switch v := v.(*UpdateBusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -566,7 +1008,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBusRequest); i {
+			switch v := v.(*UpdateBusReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -578,7 +1020,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBusReply); i {
+			switch v := v.(*DeleteBusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -590,7 +1032,7 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBusRequest); i {
+			switch v := v.(*DeleteBusReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -602,6 +1044,42 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 		file_api_bus_v1_bus_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bus_v1_bus_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bus_v1_bus_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_bus_v1_bus_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBusReply); i {
 			case 0:
 				return &v.state
@@ -614,13 +1092,16 @@ func file_api_bus_v1_bus_proto_init() {
 			}
 		}
 	}
+	file_api_bus_v1_bus_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_api_bus_v1_bus_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_api_bus_v1_bus_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_bus_v1_bus_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
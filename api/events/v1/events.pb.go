@@ -0,0 +1,1432 @@
+// Каталог доменных событий сервиса. События публикуются в topic exchange
+// fleet.events в формате protojson с именами полей как в proto (bus_id).
+// Ключ маршрутизации совпадает с Event.type, например bus.status_changed,
+// получатели привязывают свои очереди по ключам вида bus.* или *.closed.
+// Доставка не реже одного раза: повтор определяется по message_id сообщения AMQP.
+//
+// Совместимость: в пределах v1 поля только добавляются, номера полей не меняются.
+// Несовместимые изменения выпускаются как events.v2 с новым значением version.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: api/events/v1/events.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Event конверт события, заполнено ровно одно поле payload
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// тип события и ключ маршрутизации
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// версия схемы, для events.v1 всегда 1
+	Version int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Event_BusCreated
+	//	*Event_BusStatusChanged
+	//	*Event_ShiftStarted
+	//	*Event_ShiftEnded
+	//	*Event_RouteCreated
+	//	*Event_RouteUpdated
+	//	*Event_RouteDeleted
+	//	*Event_AccidentOpened
+	//	*Event_AccidentClosed
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetBusCreated() *BusCreated {
+	if x, ok := x.GetPayload().(*Event_BusCreated); ok {
+		return x.BusCreated
+	}
+	return nil
+}
+
+func (x *Event) GetBusStatusChanged() *BusStatusChanged {
+	if x, ok := x.GetPayload().(*Event_BusStatusChanged); ok {
+		return x.BusStatusChanged
+	}
+	return nil
+}
+
+func (x *Event) GetShiftStarted() *ShiftStarted {
+	if x, ok := x.GetPayload().(*Event_ShiftStarted); ok {
+		return x.ShiftStarted
+	}
+	return nil
+}
+
+func (x *Event) GetShiftEnded() *ShiftEnded {
+	if x, ok := x.GetPayload().(*Event_ShiftEnded); ok {
+		return x.ShiftEnded
+	}
+	return nil
+}
+
+func (x *Event) GetRouteCreated() *RouteCreated {
+	if x, ok := x.GetPayload().(*Event_RouteCreated); ok {
+		return x.RouteCreated
+	}
+	return nil
+}
+
+func (x *Event) GetRouteUpdated() *RouteUpdated {
+	if x, ok := x.GetPayload().(*Event_RouteUpdated); ok {
+		return x.RouteUpdated
+	}
+	return nil
+}
+
+func (x *Event) GetRouteDeleted() *RouteDeleted {
+	if x, ok := x.GetPayload().(*Event_RouteDeleted); ok {
+		return x.RouteDeleted
+	}
+	return nil
+}
+
+func (x *Event) GetAccidentOpened() *AccidentOpened {
+	if x, ok := x.GetPayload().(*Event_AccidentOpened); ok {
+		return x.AccidentOpened
+	}
+	return nil
+}
+
+func (x *Event) GetAccidentClosed() *AccidentClosed {
+	if x, ok := x.GetPayload().(*Event_AccidentClosed); ok {
+		return x.AccidentClosed
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_BusCreated struct {
+	BusCreated *BusCreated `protobuf:"bytes,10,opt,name=bus_created,json=busCreated,proto3,oneof"`
+}
+
+type Event_BusStatusChanged struct {
+	BusStatusChanged *BusStatusChanged `protobuf:"bytes,11,opt,name=bus_status_changed,json=busStatusChanged,proto3,oneof"`
+}
+
+type Event_ShiftStarted struct {
+	ShiftStarted *ShiftStarted `protobuf:"bytes,12,opt,name=shift_started,json=shiftStarted,proto3,oneof"`
+}
+
+type Event_ShiftEnded struct {
+	ShiftEnded *ShiftEnded `protobuf:"bytes,13,opt,name=shift_ended,json=shiftEnded,proto3,oneof"`
+}
+
+type Event_RouteCreated struct {
+	RouteCreated *RouteCreated `protobuf:"bytes,14,opt,name=route_created,json=routeCreated,proto3,oneof"`
+}
+
+type Event_RouteUpdated struct {
+	RouteUpdated *RouteUpdated `protobuf:"bytes,15,opt,name=route_updated,json=routeUpdated,proto3,oneof"`
+}
+
+type Event_RouteDeleted struct {
+	RouteDeleted *RouteDeleted `protobuf:"bytes,16,opt,name=route_deleted,json=routeDeleted,proto3,oneof"`
+}
+
+type Event_AccidentOpened struct {
+	AccidentOpened *AccidentOpened `protobuf:"bytes,17,opt,name=accident_opened,json=accidentOpened,proto3,oneof"`
+}
+
+type Event_AccidentClosed struct {
+	AccidentClosed *AccidentClosed `protobuf:"bytes,18,opt,name=accident_closed,json=accidentClosed,proto3,oneof"`
+}
+
+func (*Event_BusCreated) isEvent_Payload() {}
+
+func (*Event_BusStatusChanged) isEvent_Payload() {}
+
+func (*Event_ShiftStarted) isEvent_Payload() {}
+
+func (*Event_ShiftEnded) isEvent_Payload() {}
+
+func (*Event_RouteCreated) isEvent_Payload() {}
+
+func (*Event_RouteUpdated) isEvent_Payload() {}
+
+func (*Event_RouteDeleted) isEvent_Payload() {}
+
+func (*Event_AccidentOpened) isEvent_Payload() {}
+
+func (*Event_AccidentClosed) isEvent_Payload() {}
+
+// bus.created
+type BusCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusId   uint32  `protobuf:"varint,1,opt,name=bus_id,json=busId,proto3" json:"bus_id,omitempty"`
+	Number  string  `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	RouteId *uint32 `protobuf:"varint,3,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+}
+
+func (x *BusCreated) Reset() {
+	*x = BusCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BusCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusCreated) ProtoMessage() {}
+
+func (x *BusCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusCreated.ProtoReflect.Descriptor instead.
+func (*BusCreated) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BusCreated) GetBusId() uint32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *BusCreated) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *BusCreated) GetRouteId() uint32 {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return 0
+}
+
+// bus.status_changed
+type BusStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusId uint32 `protobuf:"varint,1,opt,name=bus_id,json=busId,proto3" json:"bus_id,omitempty"`
+	// статусы idle, in_service, charging, maintenance, out_of_order
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// пользователь Keycloak или системный автор изменения
+	ChangedBy string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	RouteId   *uint32                `protobuf:"varint,6,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+	// водитель автобуса после изменения
+	DriverId *string `protobuf:"bytes,7,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+}
+
+func (x *BusStatusChanged) Reset() {
+	*x = BusStatusChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BusStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusStatusChanged) ProtoMessage() {}
+
+func (x *BusStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusStatusChanged.ProtoReflect.Descriptor instead.
+func (*BusStatusChanged) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BusStatusChanged) GetBusId() uint32 {
+	if x != nil {
+		return x.BusId
+	}
+	return 0
+}
+
+func (x *BusStatusChanged) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BusStatusChanged) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *BusStatusChanged) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *BusStatusChanged) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *BusStatusChanged) GetRouteId() uint32 {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return 0
+}
+
+func (x *BusStatusChanged) GetDriverId() string {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return ""
+}
+
+// shift.started
+type ShiftStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShiftId   uint32                 `protobuf:"varint,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	DriverId  string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	BusId     *uint32                `protobuf:"varint,3,opt,name=bus_id,json=busId,proto3,oneof" json:"bus_id,omitempty"`
+	RouteId   *uint32                `protobuf:"varint,4,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (x *ShiftStarted) Reset() {
+	*x = ShiftStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShiftStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftStarted) ProtoMessage() {}
+
+func (x *ShiftStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftStarted.ProtoReflect.Descriptor instead.
+func (*ShiftStarted) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShiftStarted) GetShiftId() uint32 {
+	if x != nil {
+		return x.ShiftId
+	}
+	return 0
+}
+
+func (x *ShiftStarted) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *ShiftStarted) GetBusId() uint32 {
+	if x != nil && x.BusId != nil {
+		return *x.BusId
+	}
+	return 0
+}
+
+func (x *ShiftStarted) GetRouteId() uint32 {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return 0
+}
+
+func (x *ShiftStarted) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+// shift.ended
+type ShiftEnded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShiftId   uint32                 `protobuf:"varint,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	DriverId  string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	BusId     *uint32                `protobuf:"varint,3,opt,name=bus_id,json=busId,proto3,oneof" json:"bus_id,omitempty"`
+	RouteId   *uint32                `protobuf:"varint,4,opt,name=route_id,json=routeId,proto3,oneof" json:"route_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// смена превысила допустимую длительность
+	Overrun bool `protobuf:"varint,7,opt,name=overrun,proto3" json:"overrun,omitempty"`
+}
+
+func (x *ShiftEnded) Reset() {
+	*x = ShiftEnded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShiftEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftEnded) ProtoMessage() {}
+
+func (x *ShiftEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftEnded.ProtoReflect.Descriptor instead.
+func (*ShiftEnded) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShiftEnded) GetShiftId() uint32 {
+	if x != nil {
+		return x.ShiftId
+	}
+	return 0
+}
+
+func (x *ShiftEnded) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *ShiftEnded) GetBusId() uint32 {
+	if x != nil && x.BusId != nil {
+		return *x.BusId
+	}
+	return 0
+}
+
+func (x *ShiftEnded) GetRouteId() uint32 {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return 0
+}
+
+func (x *ShiftEnded) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ShiftEnded) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ShiftEnded) GetOverrun() bool {
+	if x != nil {
+		return x.Overrun
+	}
+	return false
+}
+
+type RouteStation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StationId uint64  `protobuf:"varint,1,opt,name=station_id,json=stationId,proto3" json:"station_id,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lat       float64 `protobuf:"fixed64,3,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon       float64 `protobuf:"fixed64,4,opt,name=lon,proto3" json:"lon,omitempty"`
+}
+
+func (x *RouteStation) Reset() {
+	*x = RouteStation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteStation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteStation) ProtoMessage() {}
+
+func (x *RouteStation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteStation.ProtoReflect.Descriptor instead.
+func (*RouteStation) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RouteStation) GetStationId() uint64 {
+	if x != nil {
+		return x.StationId
+	}
+	return 0
+}
+
+func (x *RouteStation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RouteStation) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *RouteStation) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+// route.created
+type RouteCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId  uint32          `protobuf:"varint,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	Number   string          `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Stations []*RouteStation `protobuf:"bytes,3,rep,name=stations,proto3" json:"stations,omitempty"`
+	// длина маршрута в километрах
+	Length float32 `protobuf:"fixed32,4,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *RouteCreated) Reset() {
+	*x = RouteCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteCreated) ProtoMessage() {}
+
+func (x *RouteCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteCreated.ProtoReflect.Descriptor instead.
+func (*RouteCreated) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RouteCreated) GetRouteId() uint32 {
+	if x != nil {
+		return x.RouteId
+	}
+	return 0
+}
+
+func (x *RouteCreated) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *RouteCreated) GetStations() []*RouteStation {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+func (x *RouteCreated) GetLength() float32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// route.updated
+type RouteUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId  uint32          `protobuf:"varint,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+	Number   string          `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Stations []*RouteStation `protobuf:"bytes,3,rep,name=stations,proto3" json:"stations,omitempty"`
+	Length   float32         `protobuf:"fixed32,4,opt,name=length,proto3" json:"length,omitempty"`
+	// изменился состав или порядок остановок
+	StationsChanged bool `protobuf:"varint,5,opt,name=stations_changed,json=stationsChanged,proto3" json:"stations_changed,omitempty"`
+}
+
+func (x *RouteUpdated) Reset() {
+	*x = RouteUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteUpdated) ProtoMessage() {}
+
+func (x *RouteUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteUpdated.ProtoReflect.Descriptor instead.
+func (*RouteUpdated) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RouteUpdated) GetRouteId() uint32 {
+	if x != nil {
+		return x.RouteId
+	}
+	return 0
+}
+
+func (x *RouteUpdated) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *RouteUpdated) GetStations() []*RouteStation {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+func (x *RouteUpdated) GetLength() float32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *RouteUpdated) GetStationsChanged() bool {
+	if x != nil {
+		return x.StationsChanged
+	}
+	return false
+}
+
+// route.deleted
+type RouteDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RouteId uint32 `protobuf:"varint,1,opt,name=route_id,json=routeId,proto3" json:"route_id,omitempty"`
+}
+
+func (x *RouteDeleted) Reset() {
+	*x = RouteDeleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteDeleted) ProtoMessage() {}
+
+func (x *RouteDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteDeleted.ProtoReflect.Descriptor instead.
+func (*RouteDeleted) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RouteDeleted) GetRouteId() uint32 {
+	if x != nil {
+		return x.RouteId
+	}
+	return 0
+}
+
+// accident.opened
+type AccidentOpened struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccidentId uint64                 `protobuf:"varint,1,opt,name=accident_id,json=accidentId,proto3" json:"accident_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lat        float64                `protobuf:"fixed64,3,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon        float64                `protobuf:"fixed64,4,opt,name=lon,proto3" json:"lon,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// маршруты, через которые проходит место ДТП
+	RouteIds []uint32 `protobuf:"varint,6,rep,packed,name=route_ids,json=routeIds,proto3" json:"route_ids,omitempty"`
+}
+
+func (x *AccidentOpened) Reset() {
+	*x = AccidentOpened{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccidentOpened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccidentOpened) ProtoMessage() {}
+
+func (x *AccidentOpened) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccidentOpened.ProtoReflect.Descriptor instead.
+func (*AccidentOpened) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AccidentOpened) GetAccidentId() uint64 {
+	if x != nil {
+		return x.AccidentId
+	}
+	return 0
+}
+
+func (x *AccidentOpened) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccidentOpened) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *AccidentOpened) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *AccidentOpened) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AccidentOpened) GetRouteIds() []uint32 {
+	if x != nil {
+		return x.RouteIds
+	}
+	return nil
+}
+
+// accident.closed
+type AccidentClosed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccidentId uint64                 `protobuf:"varint,1,opt,name=accident_id,json=accidentId,proto3" json:"accident_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Lat        float64                `protobuf:"fixed64,3,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon        float64                `protobuf:"fixed64,4,opt,name=lon,proto3" json:"lon,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RouteIds   []uint32               `protobuf:"varint,7,rep,packed,name=route_ids,json=routeIds,proto3" json:"route_ids,omitempty"`
+}
+
+func (x *AccidentClosed) Reset() {
+	*x = AccidentClosed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccidentClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccidentClosed) ProtoMessage() {}
+
+func (x *AccidentClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccidentClosed.ProtoReflect.Descriptor instead.
+func (*AccidentClosed) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AccidentClosed) GetAccidentId() uint64 {
+	if x != nil {
+		return x.AccidentId
+	}
+	return 0
+}
+
+func (x *AccidentClosed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccidentClosed) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *AccidentClosed) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *AccidentClosed) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AccidentClosed) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AccidentClosed) GetRouteIds() []uint32 {
+	if x != nil {
+		return x.RouteIds
+	}
+	return nil
+}
+
+var File_api_events_v1_events_proto protoreflect.FileDescriptor
+
+var file_api_events_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x05, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x38, 0x0a, 0x0b, 0x62, 0x75, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a,
+	0x62, 0x75, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x62, 0x75,
+	0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x62, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x45, 0x6e,
+	0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x68, 0x69, 0x66, 0x74, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x44, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x4f,
+	0x70, 0x65, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x61,
+	0x63, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x09, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x68, 0x0a, 0x0a, 0x42, 0x75, 0x73, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x75, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x42, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x75, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x0c, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x62, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x75,
+	0x73, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x22, 0xa4, 0x02, 0x0a, 0x0a, 0x53, 0x68, 0x69, 0x66, 0x74, 0x45, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x73, 0x68, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x06, 0x62, 0x75, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x62, 0x75, 0x73, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x65, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x22,
+	0x8e, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0c,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x61, 0x63, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6c, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x0e,
+	0x41, 0x63, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x49, 0x64, 0x73, 0x42, 0x2f, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x1c, 0x62, 0x75, 0x73, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_events_v1_events_proto_rawDescOnce sync.Once
+	file_api_events_v1_events_proto_rawDescData = file_api_events_v1_events_proto_rawDesc
+)
+
+func file_api_events_v1_events_proto_rawDescGZIP() []byte {
+	file_api_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_api_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_events_v1_events_proto_rawDescData)
+	})
+	return file_api_events_v1_events_proto_rawDescData
+}
+
+var file_api_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_events_v1_events_proto_goTypes = []interface{}{
+	(*Event)(nil),                 // 0: events.v1.Event
+	(*BusCreated)(nil),            // 1: events.v1.BusCreated
+	(*BusStatusChanged)(nil),      // 2: events.v1.BusStatusChanged
+	(*ShiftStarted)(nil),          // 3: events.v1.ShiftStarted
+	(*ShiftEnded)(nil),            // 4: events.v1.ShiftEnded
+	(*RouteStation)(nil),          // 5: events.v1.RouteStation
+	(*RouteCreated)(nil),          // 6: events.v1.RouteCreated
+	(*RouteUpdated)(nil),          // 7: events.v1.RouteUpdated
+	(*RouteDeleted)(nil),          // 8: events.v1.RouteDeleted
+	(*AccidentOpened)(nil),        // 9: events.v1.AccidentOpened
+	(*AccidentClosed)(nil),        // 10: events.v1.AccidentClosed
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_api_events_v1_events_proto_depIdxs = []int32{
+	11, // 0: events.v1.Event.time:type_name -> google.protobuf.Timestamp
+	1,  // 1: events.v1.Event.bus_created:type_name -> events.v1.BusCreated
+	2,  // 2: events.v1.Event.bus_status_changed:type_name -> events.v1.BusStatusChanged
+	3,  // 3: events.v1.Event.shift_started:type_name -> events.v1.ShiftStarted
+	4,  // 4: events.v1.Event.shift_ended:type_name -> events.v1.ShiftEnded
+	6,  // 5: events.v1.Event.route_created:type_name -> events.v1.RouteCreated
+	7,  // 6: events.v1.Event.route_updated:type_name -> events.v1.RouteUpdated
+	8,  // 7: events.v1.Event.route_deleted:type_name -> events.v1.RouteDeleted
+	9,  // 8: events.v1.Event.accident_opened:type_name -> events.v1.AccidentOpened
+	10, // 9: events.v1.Event.accident_closed:type_name -> events.v1.AccidentClosed
+	11, // 10: events.v1.BusStatusChanged.changed_at:type_name -> google.protobuf.Timestamp
+	11, // 11: events.v1.ShiftStarted.start_time:type_name -> google.protobuf.Timestamp
+	11, // 12: events.v1.ShiftEnded.start_time:type_name -> google.protobuf.Timestamp
+	11, // 13: events.v1.ShiftEnded.end_time:type_name -> google.protobuf.Timestamp
+	5,  // 14: events.v1.RouteCreated.stations:type_name -> events.v1.RouteStation
+	5,  // 15: events.v1.RouteUpdated.stations:type_name -> events.v1.RouteStation
+	11, // 16: events.v1.AccidentOpened.start_date:type_name -> google.protobuf.Timestamp
+	11, // 17: events.v1.AccidentClosed.start_date:type_name -> google.protobuf.Timestamp
+	11, // 18: events.v1.AccidentClosed.end_date:type_name -> google.protobuf.Timestamp
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_api_events_v1_events_proto_init() }
+func file_api_events_v1_events_proto_init() {
+	if File_api_events_v1_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_events_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BusCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BusStatusChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShiftStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShiftEnded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteStation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteDeleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccidentOpened); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccidentClosed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_events_v1_events_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_BusCreated)(nil),
+		(*Event_BusStatusChanged)(nil),
+		(*Event_ShiftStarted)(nil),
+		(*Event_ShiftEnded)(nil),
+		(*Event_RouteCreated)(nil),
+		(*Event_RouteUpdated)(nil),
+		(*Event_RouteDeleted)(nil),
+		(*Event_AccidentOpened)(nil),
+		(*Event_AccidentClosed)(nil),
+	}
+	file_api_events_v1_events_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_api_events_v1_events_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_api_events_v1_events_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_api_events_v1_events_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_events_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_api_events_v1_events_proto_depIdxs,
+		MessageInfos:      file_api_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_api_events_v1_events_proto = out.File
+	file_api_events_v1_events_proto_rawDesc = nil
+	file_api_events_v1_events_proto_goTypes = nil
+	file_api_events_v1_events_proto_depIdxs = nil
+}
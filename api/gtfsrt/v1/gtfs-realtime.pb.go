@@ -0,0 +1,1477 @@
+// Подмножество спецификации GTFS Realtime (https://gtfs.org/realtime/reference/),
+// номера полей совпадают с gtfs-realtime.proto, поэтому фиды читаются
+// стандартными клиентами. Описаны только сущности, которые публикует сервис.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.19.4
+// source: api/gtfsrt/v1/gtfs-realtime.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FeedHeader_Incrementality int32
+
+const (
+	FeedHeader_FULL_DATASET FeedHeader_Incrementality = 0
+	FeedHeader_DIFFERENTIAL FeedHeader_Incrementality = 1
+)
+
+// Enum value maps for FeedHeader_Incrementality.
+var (
+	FeedHeader_Incrementality_name = map[int32]string{
+		0: "FULL_DATASET",
+		1: "DIFFERENTIAL",
+	}
+	FeedHeader_Incrementality_value = map[string]int32{
+		"FULL_DATASET": 0,
+		"DIFFERENTIAL": 1,
+	}
+)
+
+func (x FeedHeader_Incrementality) Enum() *FeedHeader_Incrementality {
+	p := new(FeedHeader_Incrementality)
+	*p = x
+	return p
+}
+
+func (x FeedHeader_Incrementality) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedHeader_Incrementality) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[0].Descriptor()
+}
+
+func (FeedHeader_Incrementality) Type() protoreflect.EnumType {
+	return &file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[0]
+}
+
+func (x FeedHeader_Incrementality) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *FeedHeader_Incrementality) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = FeedHeader_Incrementality(num)
+	return nil
+}
+
+// Deprecated: Use FeedHeader_Incrementality.Descriptor instead.
+func (FeedHeader_Incrementality) EnumDescriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type VehiclePosition_VehicleStopStatus int32
+
+const (
+	VehiclePosition_INCOMING_AT   VehiclePosition_VehicleStopStatus = 0
+	VehiclePosition_STOPPED_AT    VehiclePosition_VehicleStopStatus = 1
+	VehiclePosition_IN_TRANSIT_TO VehiclePosition_VehicleStopStatus = 2
+)
+
+// Enum value maps for VehiclePosition_VehicleStopStatus.
+var (
+	VehiclePosition_VehicleStopStatus_name = map[int32]string{
+		0: "INCOMING_AT",
+		1: "STOPPED_AT",
+		2: "IN_TRANSIT_TO",
+	}
+	VehiclePosition_VehicleStopStatus_value = map[string]int32{
+		"INCOMING_AT":   0,
+		"STOPPED_AT":    1,
+		"IN_TRANSIT_TO": 2,
+	}
+)
+
+func (x VehiclePosition_VehicleStopStatus) Enum() *VehiclePosition_VehicleStopStatus {
+	p := new(VehiclePosition_VehicleStopStatus)
+	*p = x
+	return p
+}
+
+func (x VehiclePosition_VehicleStopStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VehiclePosition_VehicleStopStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[1].Descriptor()
+}
+
+func (VehiclePosition_VehicleStopStatus) Type() protoreflect.EnumType {
+	return &file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[1]
+}
+
+func (x VehiclePosition_VehicleStopStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *VehiclePosition_VehicleStopStatus) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = VehiclePosition_VehicleStopStatus(num)
+	return nil
+}
+
+// Deprecated: Use VehiclePosition_VehicleStopStatus.Descriptor instead.
+func (VehiclePosition_VehicleStopStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type Alert_Cause int32
+
+const (
+	Alert_UNKNOWN_CAUSE     Alert_Cause = 1
+	Alert_OTHER_CAUSE       Alert_Cause = 2
+	Alert_TECHNICAL_PROBLEM Alert_Cause = 3
+	Alert_STRIKE            Alert_Cause = 4
+	Alert_DEMONSTRATION     Alert_Cause = 5
+	Alert_ACCIDENT          Alert_Cause = 6
+	Alert_HOLIDAY           Alert_Cause = 7
+	Alert_WEATHER           Alert_Cause = 8
+	Alert_MAINTENANCE       Alert_Cause = 9
+	Alert_CONSTRUCTION      Alert_Cause = 10
+	Alert_POLICE_ACTIVITY   Alert_Cause = 11
+	Alert_MEDICAL_EMERGENCY Alert_Cause = 12
+)
+
+// Enum value maps for Alert_Cause.
+var (
+	Alert_Cause_name = map[int32]string{
+		1:  "UNKNOWN_CAUSE",
+		2:  "OTHER_CAUSE",
+		3:  "TECHNICAL_PROBLEM",
+		4:  "STRIKE",
+		5:  "DEMONSTRATION",
+		6:  "ACCIDENT",
+		7:  "HOLIDAY",
+		8:  "WEATHER",
+		9:  "MAINTENANCE",
+		10: "CONSTRUCTION",
+		11: "POLICE_ACTIVITY",
+		12: "MEDICAL_EMERGENCY",
+	}
+	Alert_Cause_value = map[string]int32{
+		"UNKNOWN_CAUSE":     1,
+		"OTHER_CAUSE":       2,
+		"TECHNICAL_PROBLEM": 3,
+		"STRIKE":            4,
+		"DEMONSTRATION":     5,
+		"ACCIDENT":          6,
+		"HOLIDAY":           7,
+		"WEATHER":           8,
+		"MAINTENANCE":       9,
+		"CONSTRUCTION":      10,
+		"POLICE_ACTIVITY":   11,
+		"MEDICAL_EMERGENCY": 12,
+	}
+)
+
+func (x Alert_Cause) Enum() *Alert_Cause {
+	p := new(Alert_Cause)
+	*p = x
+	return p
+}
+
+func (x Alert_Cause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Alert_Cause) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[2].Descriptor()
+}
+
+func (Alert_Cause) Type() protoreflect.EnumType {
+	return &file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[2]
+}
+
+func (x Alert_Cause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Alert_Cause) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Alert_Cause(num)
+	return nil
+}
+
+// Deprecated: Use Alert_Cause.Descriptor instead.
+func (Alert_Cause) EnumDescriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type Alert_Effect int32
+
+const (
+	Alert_NO_SERVICE         Alert_Effect = 1
+	Alert_REDUCED_SERVICE    Alert_Effect = 2
+	Alert_SIGNIFICANT_DELAYS Alert_Effect = 3
+	Alert_DETOUR             Alert_Effect = 4
+	Alert_ADDITIONAL_SERVICE Alert_Effect = 5
+	Alert_MODIFIED_SERVICE   Alert_Effect = 6
+	Alert_OTHER_EFFECT       Alert_Effect = 7
+	Alert_UNKNOWN_EFFECT     Alert_Effect = 8
+	Alert_STOP_MOVED         Alert_Effect = 9
+)
+
+// Enum value maps for Alert_Effect.
+var (
+	Alert_Effect_name = map[int32]string{
+		1: "NO_SERVICE",
+		2: "REDUCED_SERVICE",
+		3: "SIGNIFICANT_DELAYS",
+		4: "DETOUR",
+		5: "ADDITIONAL_SERVICE",
+		6: "MODIFIED_SERVICE",
+		7: "OTHER_EFFECT",
+		8: "UNKNOWN_EFFECT",
+		9: "STOP_MOVED",
+	}
+	Alert_Effect_value = map[string]int32{
+		"NO_SERVICE":         1,
+		"REDUCED_SERVICE":    2,
+		"SIGNIFICANT_DELAYS": 3,
+		"DETOUR":             4,
+		"ADDITIONAL_SERVICE": 5,
+		"MODIFIED_SERVICE":   6,
+		"OTHER_EFFECT":       7,
+		"UNKNOWN_EFFECT":     8,
+		"STOP_MOVED":         9,
+	}
+)
+
+func (x Alert_Effect) Enum() *Alert_Effect {
+	p := new(Alert_Effect)
+	*p = x
+	return p
+}
+
+func (x Alert_Effect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Alert_Effect) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[3].Descriptor()
+}
+
+func (Alert_Effect) Type() protoreflect.EnumType {
+	return &file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes[3]
+}
+
+func (x Alert_Effect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Alert_Effect) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Alert_Effect(num)
+	return nil
+}
+
+// Deprecated: Use Alert_Effect.Descriptor instead.
+func (Alert_Effect) EnumDescriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{4, 1}
+}
+
+type FeedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *FeedHeader   `protobuf:"bytes,1,req,name=header" json:"header,omitempty"`
+	Entity []*FeedEntity `protobuf:"bytes,2,rep,name=entity" json:"entity,omitempty"`
+}
+
+func (x *FeedMessage) Reset() {
+	*x = FeedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedMessage) ProtoMessage() {}
+
+func (x *FeedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedMessage.ProtoReflect.Descriptor instead.
+func (*FeedMessage) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeedMessage) GetHeader() *FeedHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *FeedMessage) GetEntity() []*FeedEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+type FeedHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GtfsRealtimeVersion *string                    `protobuf:"bytes,1,req,name=gtfs_realtime_version,json=gtfsRealtimeVersion" json:"gtfs_realtime_version,omitempty"`
+	Incrementality      *FeedHeader_Incrementality `protobuf:"varint,2,opt,name=incrementality,enum=transit_realtime.FeedHeader_Incrementality,def=0" json:"incrementality,omitempty"`
+	Timestamp           *uint64                    `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+// Default values for FeedHeader fields.
+const (
+	Default_FeedHeader_Incrementality = FeedHeader_FULL_DATASET
+)
+
+func (x *FeedHeader) Reset() {
+	*x = FeedHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedHeader) ProtoMessage() {}
+
+func (x *FeedHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedHeader.ProtoReflect.Descriptor instead.
+func (*FeedHeader) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeedHeader) GetGtfsRealtimeVersion() string {
+	if x != nil && x.GtfsRealtimeVersion != nil {
+		return *x.GtfsRealtimeVersion
+	}
+	return ""
+}
+
+func (x *FeedHeader) GetIncrementality() FeedHeader_Incrementality {
+	if x != nil && x.Incrementality != nil {
+		return *x.Incrementality
+	}
+	return Default_FeedHeader_Incrementality
+}
+
+func (x *FeedHeader) GetTimestamp() uint64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+type FeedEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        *string          `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	IsDeleted *bool            `protobuf:"varint,2,opt,name=is_deleted,json=isDeleted,def=0" json:"is_deleted,omitempty"`
+	Vehicle   *VehiclePosition `protobuf:"bytes,4,opt,name=vehicle" json:"vehicle,omitempty"`
+	Alert     *Alert           `protobuf:"bytes,5,opt,name=alert" json:"alert,omitempty"`
+}
+
+// Default values for FeedEntity fields.
+const (
+	Default_FeedEntity_IsDeleted = bool(false)
+)
+
+func (x *FeedEntity) Reset() {
+	*x = FeedEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedEntity) ProtoMessage() {}
+
+func (x *FeedEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedEntity.ProtoReflect.Descriptor instead.
+func (*FeedEntity) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeedEntity) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *FeedEntity) GetIsDeleted() bool {
+	if x != nil && x.IsDeleted != nil {
+		return *x.IsDeleted
+	}
+	return Default_FeedEntity_IsDeleted
+}
+
+func (x *FeedEntity) GetVehicle() *VehiclePosition {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *FeedEntity) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+type VehiclePosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trip          *TripDescriptor                    `protobuf:"bytes,1,opt,name=trip" json:"trip,omitempty"`
+	Vehicle       *VehicleDescriptor                 `protobuf:"bytes,8,opt,name=vehicle" json:"vehicle,omitempty"`
+	Position      *Position                          `protobuf:"bytes,2,opt,name=position" json:"position,omitempty"`
+	CurrentStatus *VehiclePosition_VehicleStopStatus `protobuf:"varint,4,opt,name=current_status,json=currentStatus,enum=transit_realtime.VehiclePosition_VehicleStopStatus,def=2" json:"current_status,omitempty"`
+	Timestamp     *uint64                            `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+// Default values for VehiclePosition fields.
+const (
+	Default_VehiclePosition_CurrentStatus = VehiclePosition_IN_TRANSIT_TO
+)
+
+func (x *VehiclePosition) Reset() {
+	*x = VehiclePosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehiclePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehiclePosition) ProtoMessage() {}
+
+func (x *VehiclePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehiclePosition.ProtoReflect.Descriptor instead.
+func (*VehiclePosition) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VehiclePosition) GetTrip() *TripDescriptor {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+func (x *VehiclePosition) GetVehicle() *VehicleDescriptor {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *VehiclePosition) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *VehiclePosition) GetCurrentStatus() VehiclePosition_VehicleStopStatus {
+	if x != nil && x.CurrentStatus != nil {
+		return *x.CurrentStatus
+	}
+	return Default_VehiclePosition_CurrentStatus
+}
+
+func (x *VehiclePosition) GetTimestamp() uint64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivePeriod    []*TimeRange      `protobuf:"bytes,1,rep,name=active_period,json=activePeriod" json:"active_period,omitempty"`
+	InformedEntity  []*EntitySelector `protobuf:"bytes,5,rep,name=informed_entity,json=informedEntity" json:"informed_entity,omitempty"`
+	Cause           *Alert_Cause      `protobuf:"varint,6,opt,name=cause,enum=transit_realtime.Alert_Cause,def=1" json:"cause,omitempty"`
+	Effect          *Alert_Effect     `protobuf:"varint,7,opt,name=effect,enum=transit_realtime.Alert_Effect,def=8" json:"effect,omitempty"`
+	HeaderText      *TranslatedString `protobuf:"bytes,10,opt,name=header_text,json=headerText" json:"header_text,omitempty"`
+	DescriptionText *TranslatedString `protobuf:"bytes,11,opt,name=description_text,json=descriptionText" json:"description_text,omitempty"`
+}
+
+// Default values for Alert fields.
+const (
+	Default_Alert_Cause  = Alert_UNKNOWN_CAUSE
+	Default_Alert_Effect = Alert_UNKNOWN_EFFECT
+)
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Alert) GetActivePeriod() []*TimeRange {
+	if x != nil {
+		return x.ActivePeriod
+	}
+	return nil
+}
+
+func (x *Alert) GetInformedEntity() []*EntitySelector {
+	if x != nil {
+		return x.InformedEntity
+	}
+	return nil
+}
+
+func (x *Alert) GetCause() Alert_Cause {
+	if x != nil && x.Cause != nil {
+		return *x.Cause
+	}
+	return Default_Alert_Cause
+}
+
+func (x *Alert) GetEffect() Alert_Effect {
+	if x != nil && x.Effect != nil {
+		return *x.Effect
+	}
+	return Default_Alert_Effect
+}
+
+func (x *Alert) GetHeaderText() *TranslatedString {
+	if x != nil {
+		return x.HeaderText
+	}
+	return nil
+}
+
+func (x *Alert) GetDescriptionText() *TranslatedString {
+	if x != nil {
+		return x.DescriptionText
+	}
+	return nil
+}
+
+type TimeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *uint64 `protobuf:"varint,1,opt,name=start" json:"start,omitempty"`
+	End   *uint64 `protobuf:"varint,2,opt,name=end" json:"end,omitempty"`
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TimeRange) GetStart() uint64 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *TimeRange) GetEnd() uint64 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
+type Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  *float32 `protobuf:"fixed32,1,req,name=latitude" json:"latitude,omitempty"`
+	Longitude *float32 `protobuf:"fixed32,2,req,name=longitude" json:"longitude,omitempty"`
+	Bearing   *float32 `protobuf:"fixed32,3,opt,name=bearing" json:"bearing,omitempty"`
+	Speed     *float32 `protobuf:"fixed32,5,opt,name=speed" json:"speed,omitempty"`
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Position) GetLatitude() float32 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *Position) GetLongitude() float32 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *Position) GetBearing() float32 {
+	if x != nil && x.Bearing != nil {
+		return *x.Bearing
+	}
+	return 0
+}
+
+func (x *Position) GetSpeed() float32 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+type TripDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TripId  *string `protobuf:"bytes,1,opt,name=trip_id,json=tripId" json:"trip_id,omitempty"`
+	RouteId *string `protobuf:"bytes,5,opt,name=route_id,json=routeId" json:"route_id,omitempty"`
+}
+
+func (x *TripDescriptor) Reset() {
+	*x = TripDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripDescriptor) ProtoMessage() {}
+
+func (x *TripDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripDescriptor.ProtoReflect.Descriptor instead.
+func (*TripDescriptor) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TripDescriptor) GetTripId() string {
+	if x != nil && x.TripId != nil {
+		return *x.TripId
+	}
+	return ""
+}
+
+func (x *TripDescriptor) GetRouteId() string {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return ""
+}
+
+type VehicleDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Label *string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`
+}
+
+func (x *VehicleDescriptor) Reset() {
+	*x = VehicleDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleDescriptor) ProtoMessage() {}
+
+func (x *VehicleDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleDescriptor.ProtoReflect.Descriptor instead.
+func (*VehicleDescriptor) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VehicleDescriptor) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *VehicleDescriptor) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+type EntitySelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgencyId  *string `protobuf:"bytes,1,opt,name=agency_id,json=agencyId" json:"agency_id,omitempty"`
+	RouteId   *string `protobuf:"bytes,2,opt,name=route_id,json=routeId" json:"route_id,omitempty"`
+	RouteType *int32  `protobuf:"varint,3,opt,name=route_type,json=routeType" json:"route_type,omitempty"`
+	StopId    *string `protobuf:"bytes,5,opt,name=stop_id,json=stopId" json:"stop_id,omitempty"`
+}
+
+func (x *EntitySelector) Reset() {
+	*x = EntitySelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntitySelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitySelector) ProtoMessage() {}
+
+func (x *EntitySelector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitySelector.ProtoReflect.Descriptor instead.
+func (*EntitySelector) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EntitySelector) GetAgencyId() string {
+	if x != nil && x.AgencyId != nil {
+		return *x.AgencyId
+	}
+	return ""
+}
+
+func (x *EntitySelector) GetRouteId() string {
+	if x != nil && x.RouteId != nil {
+		return *x.RouteId
+	}
+	return ""
+}
+
+func (x *EntitySelector) GetRouteType() int32 {
+	if x != nil && x.RouteType != nil {
+		return *x.RouteType
+	}
+	return 0
+}
+
+func (x *EntitySelector) GetStopId() string {
+	if x != nil && x.StopId != nil {
+		return *x.StopId
+	}
+	return ""
+}
+
+type TranslatedString struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Translation []*TranslatedString_Translation `protobuf:"bytes,1,rep,name=translation" json:"translation,omitempty"`
+}
+
+func (x *TranslatedString) Reset() {
+	*x = TranslatedString{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslatedString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslatedString) ProtoMessage() {}
+
+func (x *TranslatedString) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslatedString.ProtoReflect.Descriptor instead.
+func (*TranslatedString) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TranslatedString) GetTranslation() []*TranslatedString_Translation {
+	if x != nil {
+		return x.Translation
+	}
+	return nil
+}
+
+type TranslatedString_Translation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text     *string `protobuf:"bytes,1,req,name=text" json:"text,omitempty"`
+	Language *string `protobuf:"bytes,2,opt,name=language" json:"language,omitempty"`
+}
+
+func (x *TranslatedString_Translation) Reset() {
+	*x = TranslatedString_Translation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslatedString_Translation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslatedString_Translation) ProtoMessage() {}
+
+func (x *TranslatedString_Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslatedString_Translation.ProtoReflect.Descriptor instead.
+func (*TranslatedString_Translation) Descriptor() ([]byte, []int) {
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *TranslatedString_Translation) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
+	}
+	return ""
+}
+
+func (x *TranslatedString_Translation) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+var File_api_gtfsrt_v1_gtfs_realtime_proto protoreflect.FileDescriptor
+
+var file_api_gtfsrt_v1_gtfs_realtime_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x74, 0x66, 0x73, 0x72, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x74, 0x66, 0x73, 0x2d, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61,
+	0x6c, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x79, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x46, 0x65,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0xf7, 0x01, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x15, 0x67, 0x74, 0x66, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x13,
+	0x67, 0x74, 0x66, 0x73, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x46,
+	0x65, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x3a, 0x0c, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x34, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x46, 0x46,
+	0x45, 0x52, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x0a, 0x46,
+	0x65, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x3b, 0x0a, 0x07, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x05,
+	0x61, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x90, 0x03, 0x0a, 0x0f,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x54, 0x72, 0x69, 0x70, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x04, 0x74, 0x72, 0x69, 0x70, 0x12, 0x3d, 0x0a, 0x07, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x0e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53,
+	0x74, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x0d, 0x49, 0x4e, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x49, 0x54, 0x5f, 0x54, 0x4f, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x47, 0x0a, 0x11, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e,
+	0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53,
+	0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49,
+	0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x5f, 0x54, 0x4f, 0x10, 0x02, 0x22, 0xc7,
+	0x06, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65,
+	0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x61,
+	0x75, 0x73, 0x65, 0x3a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x41, 0x55,
+	0x53, 0x45, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x3a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x5f, 0x45, 0x46, 0x46, 0x45, 0x43, 0x54, 0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x12, 0x43, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x4d, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x78, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x05, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x41, 0x55, 0x53, 0x45,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x55, 0x53,
+	0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x49, 0x43, 0x41, 0x4c,
+	0x5f, 0x50, 0x52, 0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
+	0x52, 0x49, 0x4b, 0x45, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x4d, 0x4f, 0x4e, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43,
+	0x49, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x4f, 0x4c, 0x49, 0x44,
+	0x41, 0x59, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10,
+	0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45,
+	0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x45, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x44,
+	0x49, 0x43, 0x41, 0x4c, 0x5f, 0x45, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x0c,
+	0x22, 0xb5, 0x01, 0x0a, 0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
+	0x4f, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52,
+	0x45, 0x44, 0x55, 0x43, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x02,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x46, 0x49, 0x43, 0x41, 0x4e, 0x54, 0x5f,
+	0x44, 0x45, 0x4c, 0x41, 0x59, 0x53, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x54, 0x4f,
+	0x55, 0x52, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x41, 0x4c, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10,
+	0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45,
+	0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x45, 0x46, 0x46, 0x45,
+	0x43, 0x54, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
+	0x45, 0x46, 0x46, 0x45, 0x43, 0x54, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4f, 0x50,
+	0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x09, 0x22, 0x33, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x74, 0x0a,
+	0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x22, 0x44, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x70, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x11, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x6f, 0x70, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3d,
+	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x42, 0x2f, 0x0a,
+	0x0d, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x74, 0x66, 0x73, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x50, 0x01,
+	0x5a, 0x1c, 0x62, 0x75, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x74, 0x66, 0x73, 0x72, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31,
+}
+
+var (
+	file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescOnce sync.Once
+	file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescData = file_api_gtfsrt_v1_gtfs_realtime_proto_rawDesc
+)
+
+func file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescGZIP() []byte {
+	file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescOnce.Do(func() {
+		file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescData)
+	})
+	return file_api_gtfsrt_v1_gtfs_realtime_proto_rawDescData
+}
+
+var file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_gtfsrt_v1_gtfs_realtime_proto_goTypes = []interface{}{
+	(FeedHeader_Incrementality)(0),         // 0: transit_realtime.FeedHeader.Incrementality
+	(VehiclePosition_VehicleStopStatus)(0), // 1: transit_realtime.VehiclePosition.VehicleStopStatus
+	(Alert_Cause)(0),                       // 2: transit_realtime.Alert.Cause
+	(Alert_Effect)(0),                      // 3: transit_realtime.Alert.Effect
+	(*FeedMessage)(nil),                    // 4: transit_realtime.FeedMessage
+	(*FeedHeader)(nil),                     // 5: transit_realtime.FeedHeader
+	(*FeedEntity)(nil),                     // 6: transit_realtime.FeedEntity
+	(*VehiclePosition)(nil),                // 7: transit_realtime.VehiclePosition
+	(*Alert)(nil),                          // 8: transit_realtime.Alert
+	(*TimeRange)(nil),                      // 9: transit_realtime.TimeRange
+	(*Position)(nil),                       // 10: transit_realtime.Position
+	(*TripDescriptor)(nil),                 // 11: transit_realtime.TripDescriptor
+	(*VehicleDescriptor)(nil),              // 12: transit_realtime.VehicleDescriptor
+	(*EntitySelector)(nil),                 // 13: transit_realtime.EntitySelector
+	(*TranslatedString)(nil),               // 14: transit_realtime.TranslatedString
+	(*TranslatedString_Translation)(nil),   // 15: transit_realtime.TranslatedString.Translation
+}
+var file_api_gtfsrt_v1_gtfs_realtime_proto_depIdxs = []int32{
+	5,  // 0: transit_realtime.FeedMessage.header:type_name -> transit_realtime.FeedHeader
+	6,  // 1: transit_realtime.FeedMessage.entity:type_name -> transit_realtime.FeedEntity
+	0,  // 2: transit_realtime.FeedHeader.incrementality:type_name -> transit_realtime.FeedHeader.Incrementality
+	7,  // 3: transit_realtime.FeedEntity.vehicle:type_name -> transit_realtime.VehiclePosition
+	8,  // 4: transit_realtime.FeedEntity.alert:type_name -> transit_realtime.Alert
+	11, // 5: transit_realtime.VehiclePosition.trip:type_name -> transit_realtime.TripDescriptor
+	12, // 6: transit_realtime.VehiclePosition.vehicle:type_name -> transit_realtime.VehicleDescriptor
+	10, // 7: transit_realtime.VehiclePosition.position:type_name -> transit_realtime.Position
+	1,  // 8: transit_realtime.VehiclePosition.current_status:type_name -> transit_realtime.VehiclePosition.VehicleStopStatus
+	9,  // 9: transit_realtime.Alert.active_period:type_name -> transit_realtime.TimeRange
+	13, // 10: transit_realtime.Alert.informed_entity:type_name -> transit_realtime.EntitySelector
+	2,  // 11: transit_realtime.Alert.cause:type_name -> transit_realtime.Alert.Cause
+	3,  // 12: transit_realtime.Alert.effect:type_name -> transit_realtime.Alert.Effect
+	14, // 13: transit_realtime.Alert.header_text:type_name -> transit_realtime.TranslatedString
+	14, // 14: transit_realtime.Alert.description_text:type_name -> transit_realtime.TranslatedString
+	15, // 15: transit_realtime.TranslatedString.translation:type_name -> transit_realtime.TranslatedString.Translation
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_api_gtfsrt_v1_gtfs_realtime_proto_init() }
+func file_api_gtfsrt_v1_gtfs_realtime_proto_init() {
+	if File_api_gtfsrt_v1_gtfs_realtime_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehiclePosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntitySelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslatedString); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslatedString_Translation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_gtfsrt_v1_gtfs_realtime_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_gtfsrt_v1_gtfs_realtime_proto_goTypes,
+		DependencyIndexes: file_api_gtfsrt_v1_gtfs_realtime_proto_depIdxs,
+		EnumInfos:         file_api_gtfsrt_v1_gtfs_realtime_proto_enumTypes,
+		MessageInfos:      file_api_gtfsrt_v1_gtfs_realtime_proto_msgTypes,
+	}.Build()
+	File_api_gtfsrt_v1_gtfs_realtime_proto = out.File
+	file_api_gtfsrt_v1_gtfs_realtime_proto_rawDesc = nil
+	file_api_gtfsrt_v1_gtfs_realtime_proto_goTypes = nil
+	file_api_gtfsrt_v1_gtfs_realtime_proto_depIdxs = nil
+}
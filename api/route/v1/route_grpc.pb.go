@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: api/route/v1/route.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Route_CreateRoute_FullMethodName = "/api.route.v1.Route/CreateRoute"
+	Route_UpdateRoute_FullMethodName = "/api.route.v1.Route/UpdateRoute"
+	Route_DeleteRoute_FullMethodName = "/api.route.v1.Route/DeleteRoute"
+	Route_GetRoute_FullMethodName    = "/api.route.v1.Route/GetRoute"
+	Route_ListRoute_FullMethodName   = "/api.route.v1.Route/ListRoute"
+)
+
+// RouteClient is the client API for Route service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RouteClient interface {
+	CreateRoute(ctx context.Context, in *CreateRouteRequest, opts ...grpc.CallOption) (*CreateRouteReply, error)
+	UpdateRoute(ctx context.Context, in *UpdateRouteRequest, opts ...grpc.CallOption) (*UpdateRouteReply, error)
+	DeleteRoute(ctx context.Context, in *DeleteRouteRequest, opts ...grpc.CallOption) (*DeleteRouteReply, error)
+	GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteReply, error)
+	ListRoute(ctx context.Context, in *ListRouteRequest, opts ...grpc.CallOption) (*ListRouteReply, error)
+}
+
+type routeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRouteClient(cc grpc.ClientConnInterface) RouteClient {
+	return &routeClient{cc}
+}
+
+func (c *routeClient) CreateRoute(ctx context.Context, in *CreateRouteRequest, opts ...grpc.CallOption) (*CreateRouteReply, error) {
+	out := new(CreateRouteReply)
+	err := c.cc.Invoke(ctx, Route_CreateRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeClient) UpdateRoute(ctx context.Context, in *UpdateRouteRequest, opts ...grpc.CallOption) (*UpdateRouteReply, error) {
+	out := new(UpdateRouteReply)
+	err := c.cc.Invoke(ctx, Route_UpdateRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeClient) DeleteRoute(ctx context.Context, in *DeleteRouteRequest, opts ...grpc.CallOption) (*DeleteRouteReply, error) {
+	out := new(DeleteRouteReply)
+	err := c.cc.Invoke(ctx, Route_DeleteRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeClient) GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteReply, error) {
+	out := new(GetRouteReply)
+	err := c.cc.Invoke(ctx, Route_GetRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeClient) ListRoute(ctx context.Context, in *ListRouteRequest, opts ...grpc.CallOption) (*ListRouteReply, error) {
+	out := new(ListRouteReply)
+	err := c.cc.Invoke(ctx, Route_ListRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RouteServer is the server API for Route service.
+// All implementations must embed UnimplementedRouteServer
+// for forward compatibility
+type RouteServer interface {
+	CreateRoute(context.Context, *CreateRouteRequest) (*CreateRouteReply, error)
+	UpdateRoute(context.Context, *UpdateRouteRequest) (*UpdateRouteReply, error)
+	DeleteRoute(context.Context, *DeleteRouteRequest) (*DeleteRouteReply, error)
+	GetRoute(context.Context, *GetRouteRequest) (*GetRouteReply, error)
+	ListRoute(context.Context, *ListRouteRequest) (*ListRouteReply, error)
+	mustEmbedUnimplementedRouteServer()
+}
+
+// UnimplementedRouteServer must be embedded to have forward compatible implementations.
+type UnimplementedRouteServer struct {
+}
+
+func (UnimplementedRouteServer) CreateRoute(context.Context, *CreateRouteRequest) (*CreateRouteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoute not implemented")
+}
+func (UnimplementedRouteServer) UpdateRoute(context.Context, *UpdateRouteRequest) (*UpdateRouteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoute not implemented")
+}
+func (UnimplementedRouteServer) DeleteRoute(context.Context, *DeleteRouteRequest) (*DeleteRouteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoute not implemented")
+}
+func (UnimplementedRouteServer) GetRoute(context.Context, *GetRouteRequest) (*GetRouteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoute not implemented")
+}
+func (UnimplementedRouteServer) ListRoute(context.Context, *ListRouteRequest) (*ListRouteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoute not implemented")
+}
+func (UnimplementedRouteServer) mustEmbedUnimplementedRouteServer() {}
+
+// UnsafeRouteServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RouteServer will
+// result in compilation errors.
+type UnsafeRouteServer interface {
+	mustEmbedUnimplementedRouteServer()
+}
+
+func RegisterRouteServer(s grpc.ServiceRegistrar, srv RouteServer) {
+	s.RegisterService(&Route_ServiceDesc, srv)
+}
+
+func _Route_CreateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).CreateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_CreateRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).CreateRoute(ctx, req.(*CreateRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Route_UpdateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).UpdateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_UpdateRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).UpdateRoute(ctx, req.(*UpdateRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Route_DeleteRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).DeleteRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_DeleteRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).DeleteRoute(ctx, req.(*DeleteRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Route_GetRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).GetRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_GetRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).GetRoute(ctx, req.(*GetRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Route_ListRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteServer).ListRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Route_ListRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteServer).ListRoute(ctx, req.(*ListRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Route_ServiceDesc is the grpc.ServiceDesc for Route service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Route_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.route.v1.Route",
+	HandlerType: (*RouteServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRoute",
+			Handler:    _Route_CreateRoute_Handler,
+		},
+		{
+			MethodName: "UpdateRoute",
+			Handler:    _Route_UpdateRoute_Handler,
+		},
+		{
+			MethodName: "DeleteRoute",
+			Handler:    _Route_DeleteRoute_Handler,
+		},
+		{
+			MethodName: "GetRoute",
+			Handler:    _Route_GetRoute_Handler,
+		},
+		{
+			MethodName: "ListRoute",
+			Handler:    _Route_ListRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/route/v1/route.proto",
+}
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.19.4
+// source: api/station/v1/station.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Station_CreateStation_FullMethodName = "/api.station.v1.Station/CreateStation"
+	Station_UpdateStation_FullMethodName = "/api.station.v1.Station/UpdateStation"
+	Station_DeleteStation_FullMethodName = "/api.station.v1.Station/DeleteStation"
+	Station_GetStation_FullMethodName    = "/api.station.v1.Station/GetStation"
+	Station_ListStation_FullMethodName   = "/api.station.v1.Station/ListStation"
+)
+
+// StationClient is the client API for Station service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StationClient interface {
+	CreateStation(ctx context.Context, in *CreateStationRequest, opts ...grpc.CallOption) (*CreateStationReply, error)
+	UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*UpdateStationReply, error)
+	DeleteStation(ctx context.Context, in *DeleteStationRequest, opts ...grpc.CallOption) (*DeleteStationReply, error)
+	GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*GetStationReply, error)
+	ListStation(ctx context.Context, in *ListStationRequest, opts ...grpc.CallOption) (*ListStationReply, error)
+}
+
+type stationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStationClient(cc grpc.ClientConnInterface) StationClient {
+	return &stationClient{cc}
+}
+
+func (c *stationClient) CreateStation(ctx context.Context, in *CreateStationRequest, opts ...grpc.CallOption) (*CreateStationReply, error) {
+	out := new(CreateStationReply)
+	err := c.cc.Invoke(ctx, Station_CreateStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationClient) UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*UpdateStationReply, error) {
+	out := new(UpdateStationReply)
+	err := c.cc.Invoke(ctx, Station_UpdateStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationClient) DeleteStation(ctx context.Context, in *DeleteStationRequest, opts ...grpc.CallOption) (*DeleteStationReply, error) {
+	out := new(DeleteStationReply)
+	err := c.cc.Invoke(ctx, Station_DeleteStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationClient) GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*GetStationReply, error) {
+	out := new(GetStationReply)
+	err := c.cc.Invoke(ctx, Station_GetStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationClient) ListStation(ctx context.Context, in *ListStationRequest, opts ...grpc.CallOption) (*ListStationReply, error) {
+	out := new(ListStationReply)
+	err := c.cc.Invoke(ctx, Station_ListStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StationServer is the server API for Station service.
+// All implementations must embed UnimplementedStationServer
+// for forward compatibility
+type StationServer interface {
+	CreateStation(context.Context, *CreateStationRequest) (*CreateStationReply, error)
+	UpdateStation(context.Context, *UpdateStationRequest) (*UpdateStationReply, error)
+	DeleteStation(context.Context, *DeleteStationRequest) (*DeleteStationReply, error)
+	GetStation(context.Context, *GetStationRequest) (*GetStationReply, error)
+	ListStation(context.Context, *ListStationRequest) (*ListStationReply, error)
+	mustEmbedUnimplementedStationServer()
+}
+
+// UnimplementedStationServer must be embedded to have forward compatible implementations.
+type UnimplementedStationServer struct {
+}
+
+func (UnimplementedStationServer) CreateStation(context.Context, *CreateStationRequest) (*CreateStationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStation not implemented")
+}
+func (UnimplementedStationServer) UpdateStation(context.Context, *UpdateStationRequest) (*UpdateStationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStation not implemented")
+}
+func (UnimplementedStationServer) DeleteStation(context.Context, *DeleteStationRequest) (*DeleteStationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStation not implemented")
+}
+func (UnimplementedStationServer) GetStation(context.Context, *GetStationRequest) (*GetStationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStation not implemented")
+}
+func (UnimplementedStationServer) ListStation(context.Context, *ListStationRequest) (*ListStationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStation not implemented")
+}
+func (UnimplementedStationServer) mustEmbedUnimplementedStationServer() {}
+
+// UnsafeStationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StationServer will
+// result in compilation errors.
+type UnsafeStationServer interface {
+	mustEmbedUnimplementedStationServer()
+}
+
+func RegisterStationServer(s grpc.ServiceRegistrar, srv StationServer) {
+	s.RegisterService(&Station_ServiceDesc, srv)
+}
+
+func _Station_CreateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationServer).CreateStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Station_CreateStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationServer).CreateStation(ctx, req.(*CreateStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Station_UpdateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationServer).UpdateStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Station_UpdateStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationServer).UpdateStation(ctx, req.(*UpdateStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Station_DeleteStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationServer).DeleteStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Station_DeleteStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationServer).DeleteStation(ctx, req.(*DeleteStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Station_GetStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationServer).GetStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Station_GetStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationServer).GetStation(ctx, req.(*GetStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Station_ListStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationServer).ListStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Station_ListStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationServer).ListStation(ctx, req.(*ListStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Station_ServiceDesc is the grpc.ServiceDesc for Station service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Station_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.station.v1.Station",
+	HandlerType: (*StationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStation",
+			Handler:    _Station_CreateStation_Handler,
+		},
+		{
+			MethodName: "UpdateStation",
+			Handler:    _Station_UpdateStation_Handler,
+		},
+		{
+			MethodName: "DeleteStation",
+			Handler:    _Station_DeleteStation_Handler,
+		},
+		{
+			MethodName: "GetStation",
+			Handler:    _Station_GetStation_Handler,
+		},
+		{
+			MethodName: "ListStation",
+			Handler:    _Station_ListStation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/station/v1/station.proto",
+}
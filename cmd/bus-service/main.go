@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"bus-service/internal/conf"
+	"bus-service/internal/server"
 	"bus-service/pkg/customhttp"
 	"bus-service/pkg/rabbit"
 
@@ -40,7 +41,9 @@ func newApp(logger log.Logger,
 	gs *grpc.Server,
 	hs *http.Server,
 	rabbit *rabbit.RabbitConn,
-	customHttp *customhttp.CustomHTTP) *kratos.App {
+	customHttp *customhttp.CustomHTTP,
+	regulation *server.RegulationWatcher,
+	outbox *server.OutboxRelay) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -52,6 +55,8 @@ func newApp(logger log.Logger,
 			hs,
 			rabbit,
 			customHttp.Http,
+			regulation,
+			outbox,
 		),
 	)
 }
@@ -12,6 +12,7 @@ import (
 	"bus-service/internal/data"
 	"bus-service/internal/route"
 	"bus-service/internal/server"
+	"bus-service/internal/service"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -24,7 +25,6 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	grpcServer := server.NewGRPCServer(confServer, logger)
 	db := data.NewDB(confData)
 	goCloak := data.NewKeycloak(confData)
 	keycloakAPI := data.NewKeyCloakAPI(confData, goCloak, logger)
@@ -33,22 +33,67 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 		return nil, nil, err
 	}
 	busRepo := data.NewBusRepo(dataData, logger)
-	busUseCase := biz.NewBusUseCase(busRepo, logger)
-	shiftRepo := data.NewShiftRepo(dataData)
-	shiftUseCase := biz.NewShiftUseCase(shiftRepo)
-	busRouter := route.NewBusRouter(busUseCase, shiftUseCase)
+	busStatusRepo := data.NewBusStatusRepo(dataData)
+	fleetStream := biz.NewFleetStream()
+	outboxRepo := data.NewOutboxRepo(dataData)
+	rabbitData := data.NewRabbit(confData, logger)
+	outboxPublisher := data.NewOutboxPublisher(rabbitData)
+	transaction := data.NewTransaction(dataData)
+	outbox := biz.NewOutbox(outboxRepo, outboxPublisher, transaction)
+	notifier := biz.NewNotifier(outbox)
+	busUseCase := biz.NewBusUseCase(busRepo, busStatusRepo, fleetStream, notifier, outbox, transaction, logger)
+	busService := service.NewBusService(busUseCase)
 	routeRepo := data.NewRouterRepo(dataData, logger)
 	mapClient := data.NewMapService(confData)
-	rabbitData := data.NewRabbit(confData)
-	routeUseCase := biz.NewRouteUseCase(routeRepo, logger, mapClient, rabbitData)
-	routeRouter := route.NewRouteRouter(routeUseCase, mapClient)
+	routeUseCase := biz.NewRouteUseCase(routeRepo, transaction, logger, mapClient, notifier, outbox)
+	routeService := service.NewRouteService(routeUseCase)
+	stationRepo := data.NewStationsRepo(dataData, logger)
+	stationUseCase := biz.NewStationUseCase(stationRepo)
+	stationService := service.NewStationService(stationUseCase)
+	grpcServer := server.NewGRPCServer(confServer, busService, routeService, stationService, keycloakAPI, logger)
+	shiftRepo := data.NewShiftRepo(dataData)
+	batteryRepo := data.NewBatteryRepo(dataData)
+	positionRepo := data.NewPositionRepo(dataData)
+	batteryUseCase := biz.NewBatteryUseCase(batteryRepo, busRepo, routeRepo, positionRepo, fleetStream, logger)
+	regulation := data.NewRegulation(confData)
+	shiftUseCase := biz.NewShiftUseCase(shiftRepo, busUseCase, batteryUseCase, transaction, regulation, outbox, logger)
+	energyPredictor := data.NewAiRoute(dataData)
+	energyUseCase := biz.NewEnergyUseCase(energyPredictor, routeRepo)
+	rosterRepo := data.NewRosterRepo(dataData)
+	rosterUseCase := biz.NewRosterUseCase(rosterRepo)
+	busRouter := route.NewBusRouter(busUseCase, shiftUseCase, energyUseCase, rosterUseCase)
+	routeRouter := route.NewRouteRouter(routeUseCase, energyUseCase)
 	driverRepo := data.NewDriverRepo(dataData)
 	driverUseCase := biz.NewDriverUseCase(driverRepo)
-	driverRoute := route.NewDriverRoute(driverUseCase)
-	httpServer := server.NewHTTPServer(confServer, busRouter, keycloakAPI, routeRouter, driverRoute, logger)
-	rabbitConn := server.NewRabbitConn(rabbitData, routeUseCase)
+	driverRoute := route.NewDriverRoute(driverUseCase, shiftUseCase, rosterUseCase)
+	timetableRepo := data.NewTimetableRepo(dataData)
+	gtfsAgency, err := data.NewGtfsAgency(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	timetableUseCase := biz.NewTimetableUseCase(timetableRepo, routeRepo, stationRepo, gtfsAgency)
+	stationRouter := route.NewStationRouter(stationUseCase, timetableUseCase)
+	positionUseCase := biz.NewPositionUseCase(positionRepo, busRepo, keycloakAPI, fleetStream, logger)
+	positionRouter := route.NewPositionRouter(positionUseCase)
+	streamRouter := route.NewStreamRouter(fleetStream)
+	batteryRouter := route.NewBatteryRouter(batteryUseCase)
+	rosterRouter := route.NewRosterRouter(rosterUseCase)
+	timetableRouter := route.NewTimetableRouter(timetableUseCase)
+	gtfsUseCase := biz.NewGtfsUseCase(gtfsAgency, routeRepo, stationRepo, timetableRepo, routeUseCase, transaction, outbox, notifier)
+	accidentRepo := data.NewAccidentRepo(dataData)
+	gtfsRealtimeUseCase := biz.NewGtfsRealtimeUseCase(positionRepo, accidentRepo)
+	gtfsRouter := route.NewGtfsRouter(gtfsUseCase, gtfsRealtimeUseCase)
+	accidentUseCase := biz.NewAccidentUseCase(accidentRepo, routeUseCase, transaction, notifier, outbox, fleetStream, logger)
+	accidentRouter := route.NewAccidentRouter(accidentUseCase)
+	healthRouter := route.NewHealthRouter(rabbitData)
+	deadLetterRouter := route.NewDeadLetterRouter(rabbitData)
+	httpServer := server.NewHTTPServer(confServer, busRouter, keycloakAPI, routeRouter, driverRoute, stationRouter, positionRouter, streamRouter, batteryRouter, rosterRouter, timetableRouter, gtfsRouter, accidentRouter, healthRouter, deadLetterRouter, logger)
+	rabbitConn := server.NewRabbitConn(rabbitData, accidentUseCase, positionUseCase)
 	customHTTP := server.NewCustomHttp(confServer, busRouter, keycloakAPI, routeRouter, driverRoute, logger)
-	app := newApp(logger, grpcServer, httpServer, rabbitConn, customHTTP)
+	regulationWatcher := server.NewRegulationWatcher(confData, shiftUseCase, logger)
+	outboxRelay := server.NewOutboxRelay(outbox, rabbitData, logger)
+	app := newApp(logger, grpcServer, httpServer, rabbitConn, customHTTP, regulationWatcher, outboxRelay)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -6,6 +6,7 @@ require (
 	github.com/go-kratos/kratos/v2 v2.7.0
 	github.com/go-playground/validator/v10 v10.16.0
 	github.com/google/wire v0.5.0
+	github.com/jackc/pgx/v5 v5.4.3
 	github.com/rabbitmq/amqp091-go v1.9.0
 	github.com/swaggo/swag v1.16.2
 	go.uber.org/automaxprocs v1.5.1
@@ -32,7 +33,6 @@ require (
 	github.com/google/subcommands v1.0.1 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
-	github.com/jackc/pgx/v5 v5.4.3 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/josharian/intern v1.0.0 // indirect
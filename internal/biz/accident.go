@@ -0,0 +1,290 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAccidentClosed       = errors.New("ACCIDENT_CLOSED")
+	ErrInvalidAccidentDates = errors.New("INVALID_ACCIDENT_DATES")
+	// ErrRouteCheckFailed часть маршрутов не проверена, ДТП не сохранено
+	ErrRouteCheckFailed = errors.New("ROUTE_CHECK_FAILED")
+	// ErrAccidentChanged координаты изменились после проверки маршрутов
+	ErrAccidentChanged = errors.New("ACCIDENT_CHANGED")
+)
+
+type AccidentPatch struct {
+	Id      uint64
+	Name    *string
+	Lat     *float64
+	Lon     *float64
+	EndDate *time.Time
+}
+
+type AccidentRepo interface {
+	Create(context.Context, *Accident) error
+	Update(context.Context, *Accident) error
+	GetById(context.Context, uint64) (*Accident, error)
+	// GetByIdForUpdate блокирует строку ДТП до конца транзакции
+	GetByIdForUpdate(context.Context, uint64) (*Accident, error)
+	GetByExternalID(context.Context, uint64) (*Accident, error)
+	// List ДТП по убыванию даты начала, active оставляет только незакрытые
+	List(ctx context.Context, active bool) ([]*Accident, error)
+	// SetRoutes перезаписывает список затронутых маршрутов
+	SetRoutes(ctx context.Context, id uint64, routeIDs []uint32) error
+}
+
+type AccidentUseCase struct {
+	repo     AccidentRepo
+	routes   *RouteUseCase
+	tx       Transaction
+	notifier *Notifier
+	outbox   *Outbox
+	stream   *FleetStream
+	logger   *log.Helper
+}
+
+func NewAccidentUseCase(repo AccidentRepo, routes *RouteUseCase, tx Transaction, notifier *Notifier, outbox *Outbox, stream *FleetStream, logger log.Logger) *AccidentUseCase {
+	return &AccidentUseCase{repo: repo, routes: routes, tx: tx, notifier: notifier, outbox: outbox, stream: stream, logger: log.NewHelper(logger)}
+}
+
+// Receive принимает ДТП из очереди accident. Id сообщения считается id во внешней
+// системе: повторная доставка и последующие изменения того же ДТП применяются
+// к уже сохраненной записи
+func (uc *AccidentUseCase) Receive(ctx context.Context, incoming *Accident) error {
+	if incoming.Id == 0 {
+		return uc.Create(ctx, incoming)
+	}
+	externalID := incoming.Id
+	current, err := uc.repo.GetByExternalID(ctx, externalID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		incoming.ExternalID = &externalID
+		return uc.Create(ctx, incoming)
+	}
+	if err != nil {
+		return err
+	}
+	if current.EndDate != nil {
+		// ДТП уже закрыто, сообщение пришло повторно
+		return nil
+	}
+	_, err = uc.Update(ctx, &AccidentPatch{
+		Id:      current.Id,
+		Name:    &incoming.Name,
+		Lat:     &incoming.Lat,
+		Lon:     &incoming.Lon,
+		EndDate: incoming.EndDate,
+	})
+	if errors.Is(err, ErrAccidentClosed) {
+		// закрыто параллельной доставкой
+		return nil
+	}
+	return err
+}
+
+// Create сохраняет ДТП и находит маршруты, которые через него проходят
+func (uc *AccidentUseCase) Create(ctx context.Context, accident *Accident) error {
+	// идентификатор назначает база, внешний id передается в ExternalID
+	accident.Id = 0
+	if accident.StartDate.IsZero() {
+		accident.StartDate = time.Now()
+	}
+	if err := validateDates(accident.StartDate, accident.EndDate); err != nil {
+		return err
+	}
+	result, err := uc.checkRoutes(ctx, accident)
+	if err != nil {
+		return err
+	}
+	// ДТП, маршруты и сообщения outbox пишутся одной короткой транзакцией
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Create(ctx, accident); err != nil {
+			return err
+		}
+		if err := uc.setRoutes(ctx, accident, result); err != nil {
+			return err
+		}
+		return uc.outbox.Event(ctx, accidentOpenedEvent(accident))
+	})
+	if err != nil {
+		return err
+	}
+	uc.publish(accident)
+	return nil
+}
+
+// checkRoutes проверяет маршруты через map-service. Если часть маршрутов проверить
+// не удалось, возвращается ошибка, и сообщение уходит в очередь повторов
+func (uc *AccidentUseCase) checkRoutes(ctx context.Context, accident *Accident) (*AccidentResult, error) {
+	result, err := uc.routes.CheckAccident(ctx, accident)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Failed) > 0 {
+		return nil, fmt.Errorf("%w: %d of %d routes", ErrRouteCheckFailed, len(result.Failed), result.Checked)
+	}
+	return result, nil
+}
+
+// setRoutes сохраняет затронутые маршруты и пишет уведомление о ДТП в outbox
+func (uc *AccidentUseCase) setRoutes(ctx context.Context, accident *Accident, result *AccidentResult) error {
+	accident.RouteIDs = result.Affected
+	if err := uc.repo.SetRoutes(ctx, accident.Id, result.Affected); err != nil {
+		return err
+	}
+	if len(result.Affected) == 0 {
+		return nil
+	}
+	notification := &Notification{
+		Type:         NotificationAccident,
+		RouteIDs:     result.Affected,
+		RouteNumbers: make([]string, 0, len(result.Affected)),
+		AccidentID:   &accident.Id,
+		Lat:          &accident.Lat,
+		Lon:          &accident.Lon,
+	}
+	for _, routeID := range result.Affected {
+		notification.RouteNumbers = append(notification.RouteNumbers, result.Numbers[routeID])
+	}
+	return uc.notifier.Publish(ctx, notification)
+}
+
+// publish отправляет ДТП в поток, вызывается после коммита
+func (uc *AccidentUseCase) publish(accident *Accident) {
+	uc.stream.Publish(FleetEvent{
+		Type:     FleetEventAccident,
+		RouteIDs: accident.RouteIDs,
+		Data:     accident,
+	})
+}
+
+// Update меняет ДТП. При смене координат маршруты подбираются заново,
+// при закрытии в outbox пишется сообщение о восстановлении движения
+func (uc *AccidentUseCase) Update(ctx context.Context, patch *AccidentPatch) (*Accident, error) {
+	// новые координаты проверяются до транзакции
+	var checked *Accident
+	var result *AccidentResult
+	if patch.EndDate == nil && (patch.Lat != nil || patch.Lon != nil) {
+		current, err := uc.repo.GetById(ctx, patch.Id)
+		if err != nil {
+			return nil, err
+		}
+		checked = &Accident{Id: current.Id, Lat: current.Lat, Lon: current.Lon}
+		if patch.Lat != nil {
+			checked.Lat = *patch.Lat
+		}
+		if patch.Lon != nil {
+			checked.Lon = *patch.Lon
+		}
+		if checked.Lat != current.Lat || checked.Lon != current.Lon {
+			result, err = uc.checkRoutes(ctx, checked)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var accident *Accident
+	moved := false
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		accident, err = uc.repo.GetByIdForUpdate(ctx, patch.Id)
+		if err != nil {
+			return err
+		}
+		if accident.EndDate != nil {
+			return ErrAccidentClosed
+		}
+		if err := validateDates(accident.StartDate, patch.EndDate); err != nil {
+			return err
+		}
+		if patch.Name != nil {
+			accident.Name = *patch.Name
+		}
+		if patch.Lat != nil && *patch.Lat != accident.Lat {
+			accident.Lat = *patch.Lat
+			moved = true
+		}
+		if patch.Lon != nil && *patch.Lon != accident.Lon {
+			accident.Lon = *patch.Lon
+			moved = true
+		}
+		accident.EndDate = patch.EndDate
+		if err := uc.repo.Update(ctx, accident); err != nil {
+			return err
+		}
+		if moved && accident.EndDate == nil {
+			if result == nil || checked.Lat != accident.Lat || checked.Lon != accident.Lon {
+				return ErrAccidentChanged
+			}
+			return uc.setRoutes(ctx, accident, result)
+		}
+		if accident.EndDate == nil {
+			return nil
+		}
+		if err := uc.restored(ctx, accident); err != nil {
+			return err
+		}
+		return uc.outbox.Event(ctx, accidentClosedEvent(accident))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if moved && accident.EndDate == nil {
+		uc.publish(accident)
+	}
+	return accident, nil
+}
+
+// validateDates ДТП не может закончиться раньше начала или в будущем
+func validateDates(start time.Time, end *time.Time) error {
+	if end == nil {
+		return nil
+	}
+	if end.Before(start) || end.After(time.Now()) {
+		return ErrInvalidAccidentDates
+	}
+	return nil
+}
+
+func (uc *AccidentUseCase) GetById(ctx context.Context, id uint64) (*Accident, error) {
+	return uc.repo.GetById(ctx, id)
+}
+
+func (uc *AccidentUseCase) List(ctx context.Context, active bool) ([]*Accident, error) {
+	return uc.repo.List(ctx, active)
+}
+
+// restored сообщает в social о восстановлении движения по затронутым маршрутам
+func (uc *AccidentUseCase) restored(ctx context.Context, accident *Accident) error {
+	if len(accident.RouteIDs) == 0 {
+		return nil
+	}
+	notification := &Notification{
+		Type:         NotificationAccidentCleared,
+		RouteIDs:     make([]uint32, 0, len(accident.RouteIDs)),
+		RouteNumbers: make([]string, 0, len(accident.RouteIDs)),
+		AccidentID:   &accident.Id,
+		Lat:          &accident.Lat,
+		Lon:          &accident.Lon,
+		Time:         *accident.EndDate,
+	}
+	for _, routeID := range accident.RouteIDs {
+		route, err := uc.routes.GetById(ctx, routeID)
+		if err != nil {
+			uc.logger.Errorf("accident %d restored: route %d: %s", accident.Id, routeID, err)
+			continue
+		}
+		notification.RouteIDs = append(notification.RouteIDs, route.Id)
+		notification.RouteNumbers = append(notification.RouteNumbers, route.Number)
+	}
+	if len(notification.RouteIDs) == 0 {
+		return nil
+	}
+	return uc.notifier.Publish(ctx, notification)
+}
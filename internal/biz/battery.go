@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const FleetEventBattery = "bus.battery"
+
+// BatteryHistory расход батареи автобуса за одну смену на маршруте
+type BatteryHistory struct {
+	Id      uint      `json:"id"`
+	BusID   uint32    `json:"bus_id"`
+	RouteID uint32    `json:"route_id"`
+	Date    time.Time `json:"date"`
+	// RouteDistance пройденное расстояние, км
+	RouteDistance float32 `json:"route_distance"`
+	// Duration длительность смены, с
+	Duration float32 `json:"duration"`
+	// BatterUsage израсходованный заряд, %
+	BatterUsage float32 `json:"battery_usage"`
+}
+
+type BatteryRepo interface {
+	Create(context.Context, *BatteryHistory) error
+	List(ctx context.Context, busID uint32, from, to *time.Time) ([]*BatteryHistory, error)
+}
+
+type BatteryUseCase struct {
+	repo      BatteryRepo
+	buses     BusRepo
+	routes    RouteRepo
+	positions PositionRepo
+	stream    *FleetStream
+	logger    *log.Helper
+}
+
+func NewBatteryUseCase(repo BatteryRepo, buses BusRepo, routes RouteRepo, positions PositionRepo, stream *FleetStream, logger log.Logger) *BatteryUseCase {
+	return &BatteryUseCase{
+		repo:      repo,
+		buses:     buses,
+		routes:    routes,
+		positions: positions,
+		stream:    stream,
+		logger:    log.NewHelper(logger),
+	}
+}
+
+func (uc *BatteryUseCase) SetLevel(ctx context.Context, busID uint32, level uint) error {
+	if level > 100 {
+		return errors.New("INVALID_BATTERY_LEVEL")
+	}
+	bus, err := uc.buses.GetById(ctx, busID)
+	if err != nil {
+		return err
+	}
+	if err := uc.buses.UpdateBattery(ctx, busID, level); err != nil {
+		return err
+	}
+	uc.stream.Publish(FleetEvent{
+		Type:     FleetEventBattery,
+		RouteIDs: routeIDs(bus.RouteID),
+		Data: map[string]interface{}{
+			"bus_id": busID,
+			"level":  level,
+		},
+	})
+	return nil
+}
+
+// RecordShift сохраняет расход батареи за закрытую смену.
+// Смены без маршрута или без заряда на начало смены не учитываются, как и смены,
+// за которые автобус подзаряжался: расход по ним не известен.
+func (uc *BatteryUseCase) RecordShift(ctx context.Context, shift *Shift, bus *Bus) error {
+	if bus.RouteID == nil || shift.StartBattery == nil || shift.EndDate == nil {
+		return nil
+	}
+	if bus.BatteryLevel > *shift.StartBattery {
+		uc.logger.Infof("shift %d: bus %d charged during the shift, usage not recorded", shift.Id, bus.Id)
+		return nil
+	}
+	usage := float32(*shift.StartBattery - bus.BatteryLevel)
+	distance, err := uc.distance(ctx, bus, shift.StartTime, *shift.EndDate)
+	if err != nil {
+		return err
+	}
+	return uc.repo.Create(ctx, &BatteryHistory{
+		BusID:         bus.Id,
+		RouteID:       *bus.RouteID,
+		Date:          *shift.EndDate,
+		RouteDistance: distance,
+		Duration:      float32(shift.EndDate.Sub(shift.StartTime).Seconds()),
+		BatterUsage:   usage,
+	})
+}
+
+// distance пробег в километрах по GPS-отметкам, а без них длина маршрута
+func (uc *BatteryUseCase) distance(ctx context.Context, bus *Bus, from, to time.Time) (float32, error) {
+	track, err := uc.positions.History(ctx, bus.Id, from, to)
+	if err != nil {
+		return 0, err
+	}
+	if len(track) > 1 {
+		km := 0.0
+		for i := 1; i < len(track); i++ {
+			km += haversine(track[i-1].Lat, track[i-1].Lon, track[i].Lat, track[i].Lon)
+		}
+		return float32(km), nil
+	}
+	route, err := uc.routes.GetById(ctx, *bus.RouteID)
+	if err != nil {
+		return 0, err
+	}
+	return route.DistanceKm(), nil
+}
+
+func (uc *BatteryUseCase) History(ctx context.Context, busID uint32, from, to *time.Time) ([]*BatteryHistory, error) {
+	return uc.repo.List(ctx, busID, from, to)
+}
+
+const earthRadiusKm = 6371.0
+
+// haversine расстояние между двумя точками в километрах
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
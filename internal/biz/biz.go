@@ -1,20 +1,30 @@
 package biz
 
 import (
+	"bus-service/pkg/rabbit"
 	"context"
 
 	"github.com/google/wire"
-	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewBusUseCase, NewRouteUseCase, NewDriverUseCase, NewShiftUseCase)
+var ProviderSet = wire.NewSet(NewBusUseCase, NewRouteUseCase, NewDriverUseCase, NewShiftUseCase, NewStationUseCase, NewPositionUseCase, NewFleetStream, NewBatteryUseCase, NewEnergyUseCase, NewRosterUseCase, NewTimetableUseCase, NewGtfsUseCase, NewAccidentUseCase, NewGtfsRealtimeUseCase, NewNotifier, NewOutbox)
 
 type Transaction interface {
 	ExecTx(context.Context, func(ctx context.Context) error) error
+	// AfterCommit выполняет fn после коммита внешней транзакции, при откате fn
+	// не вызывается. Вне транзакции fn выполняется сразу
+	AfterCommit(ctx context.Context, fn func())
+}
+
+// DispatcherRole роль realm для административных операций
+const DispatcherRole = "dispatcher"
+
+// RoleChecker проверяет роль пользователя realm
+type RoleChecker interface {
+	HasRealmRole(userID string, roleName string) (bool, error)
 }
 
 type RabbitData struct {
-	Ch   *amqp.Channel
-	Conn *amqp.Connection
+	Conn *rabbit.RabbitConn
 }
@@ -2,6 +2,8 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -10,9 +12,14 @@ type Bus struct {
 	Id      uint32
 	RouteID *uint32
 	Route   *Route
-	Driver  BusUser
-	Number  string
-	Status  string
+	// DriverID водитель из строки автобуса, по нему проверяется занятость
+	DriverID *string
+	// Driver данные водителя из Keycloak, только для ответа
+	Driver BusUser
+	Number string
+	Status BusStatus
+	// заряд батареи в процентах
+	BatteryLevel uint
 }
 
 type BusDTO struct {
@@ -20,7 +27,7 @@ type BusDTO struct {
 	RouteID  *uint32
 	DriverID *string
 	Number   string
-	Status   string
+	Status   BusStatus
 }
 
 type BusUser struct {
@@ -33,38 +40,166 @@ type BusUser struct {
 
 type BusRepo interface {
 	Create(context.Context, *BusDTO) error
+	// Update меняет номер и маршрут автобуса
 	Update(context.Context, *BusDTO) error
+	// SetState меняет статус и водителя автобуса
+	SetState(ctx context.Context, id uint32, status BusStatus, driverID *string) error
 	GetById(context.Context, uint32) (*Bus, error)
+	// GetByIdForUpdate блокирует строку автобуса до конца транзакции
+	GetByIdForUpdate(context.Context, uint32) (*Bus, error)
 	List(context.Context) ([]*Bus, int64, error)
 	Delete(context.Context, uint32) error
 	GetActiveBus(context.Context) ([]*Bus, error)
+	UpdateBattery(context.Context, uint32, uint) error
+	// RouteAndDriver маршрут и водитель автобуса без обращения к Keycloak
+	RouteAndDriver(ctx context.Context, id uint32) (routeID *uint32, driverID *string, err error)
 }
 
 type BusUseCase struct {
-	repo   BusRepo
-	logger *log.Helper
+	repo     BusRepo
+	status   BusStatusRepo
+	stream   *FleetStream
+	notifier *Notifier
+	outbox   *Outbox
+	tx       Transaction
+	logger   *log.Helper
 }
 
-func NewBusUseCase(repo BusRepo, logger log.Logger) *BusUseCase {
-	return &BusUseCase{repo: repo, logger: log.NewHelper(logger)}
+func NewBusUseCase(repo BusRepo, status BusStatusRepo, stream *FleetStream, notifier *Notifier, outbox *Outbox, tx Transaction, logger log.Logger) *BusUseCase {
+	return &BusUseCase{repo: repo, status: status, stream: stream, notifier: notifier, outbox: outbox, tx: tx, logger: log.NewHelper(logger)}
 }
 
 func (uc *BusUseCase) Create(ctx context.Context, bus *BusDTO) error {
-	return uc.repo.Create(ctx, bus)
+	bus.Status = BusStatusIdle
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Create(ctx, bus); err != nil {
+			return err
+		}
+		return uc.outbox.Event(ctx, busCreatedEvent(bus))
+	})
 }
 
+// Update меняет номер и маршрут автобуса. Статус и водитель меняются
+// только через ChangeStatus и Detach
 func (uc *BusUseCase) Update(ctx context.Context, bus *BusDTO) error {
 	return uc.repo.Update(ctx, bus)
 }
 
+// ChangeStatus переводит автобус в статус to и назначает ему водителя driverID.
+// Переход проверяется по busTransitions и записывается в историю статусов.
+func (uc *BusUseCase) ChangeStatus(ctx context.Context, bus *Bus, to BusStatus, driverID *string, changedBy string) error {
+	if !bus.Status.CanTransition(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, bus.Status, to)
+	}
+	dto := &BusDTO{
+		Id:       bus.Id,
+		RouteID:  bus.RouteID,
+		DriverID: driverID,
+		Number:   bus.Number,
+		Status:   to,
+	}
+	if err := uc.repo.SetState(ctx, bus.Id, to, driverID); err != nil {
+		return err
+	}
+	change := &BusStatusChange{
+		BusID:     bus.Id,
+		From:      bus.Status,
+		To:        to,
+		ChangedBy: changedBy,
+		ChangedAt: time.Now(),
+	}
+	if err := uc.status.Create(ctx, change); err != nil {
+		return err
+	}
+	if err := uc.outbox.Event(ctx, busStatusChangedEvent(change, dto)); err != nil {
+		return err
+	}
+	if bus.Status == BusStatusInService && to == BusStatusOutOfOrder {
+		if err := uc.breakdown(ctx, bus); err != nil {
+			return err
+		}
+	}
+	uc.tx.AfterCommit(ctx, func() {
+		uc.stream.Publish(FleetEvent{
+			Type:     FleetEventBusStatus,
+			RouteIDs: routeIDs(bus.RouteID),
+			Data:     dto,
+		})
+	})
+	return nil
+}
+
+// breakdown сообщает в social о сходе автобуса с линии
+func (uc *BusUseCase) breakdown(ctx context.Context, bus *Bus) error {
+	if bus.RouteID == nil || bus.Route == nil {
+		return nil
+	}
+	busID := bus.Id
+	return uc.notifier.Publish(ctx, &Notification{
+		Type:         NotificationBusBreakdown,
+		RouteIDs:     []uint32{*bus.RouteID},
+		RouteNumbers: []string{bus.Route.Number},
+		BusID:        &busID,
+		BusNumber:    bus.Number,
+	})
+}
+
+// Detach снимает водителя с автобуса, не меняя статус
+func (uc *BusUseCase) Detach(ctx context.Context, bus *Bus, changedBy string) error {
+	dto := &BusDTO{
+		Id:      bus.Id,
+		RouteID: bus.RouteID,
+		Number:  bus.Number,
+		Status:  bus.Status,
+	}
+	if err := uc.repo.SetState(ctx, bus.Id, bus.Status, nil); err != nil {
+		return err
+	}
+	uc.logger.Infof("driver removed from bus %d by %s", bus.Id, changedBy)
+	uc.tx.AfterCommit(ctx, func() {
+		uc.stream.Publish(FleetEvent{
+			Type:     FleetEventBusStatus,
+			RouteIDs: routeIDs(bus.RouteID),
+			Data:     dto,
+		})
+	})
+	return nil
+}
+
+func (uc *BusUseCase) StatusHistory(ctx context.Context, busID uint32) ([]*BusStatusChange, error) {
+	return uc.status.List(ctx, busID)
+}
+
 func (uc *BusUseCase) GetById(ctx context.Context, id uint32) (*Bus, error) {
-	return uc.repo.GetById(context.TODO(), id)
+	return uc.repo.GetById(ctx, id)
+}
+
+func (uc *BusUseCase) GetByIdForUpdate(ctx context.Context, id uint32) (*Bus, error) {
+	return uc.repo.GetByIdForUpdate(ctx, id)
 }
 
 func (uc *BusUseCase) Delete(ctx context.Context, id uint32) error {
-	return uc.repo.Delete(context.TODO(), id)
+	return uc.repo.Delete(ctx, id)
 }
 
 func (uc *BusUseCase) List(ctx context.Context) ([]*Bus, int64, error) {
 	return uc.repo.List(ctx)
 }
+
+// SetStatus ручная смена статуса диспетчером. В работу автобус переводится
+// только началом смены, а автобус с водителем можно лишь вывести из строя.
+func (uc *BusUseCase) SetStatus(ctx context.Context, busID uint32, to BusStatus, changedBy string) error {
+	if to == BusStatusInService {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, "manual", to)
+	}
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		bus, err := uc.repo.GetByIdForUpdate(ctx, busID)
+		if err != nil {
+			return err
+		}
+		if bus.DriverID != nil && to != BusStatusOutOfOrder {
+			return ErrBusHasDriver
+		}
+		return uc.ChangeStatus(ctx, bus, to, bus.DriverID, changedBy)
+	})
+}
@@ -0,0 +1,57 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+)
+
+// EnergyEstimate прогноз расхода батареи на один рейс по маршруту
+type EnergyEstimate struct {
+	RouteID uint32  `json:"route_id"`
+	BusID   *uint32 `json:"bus_id,omitempty"`
+	// Distance длина рейса, км
+	Distance float32 `json:"distance"`
+	// Duration время рейса по данным map-service, с
+	Duration float32 `json:"duration"`
+	// Usage расход заряда, %
+	Usage float32 `json:"usage"`
+	// Samples количество записей истории, на которых обучена модель
+	Samples int `json:"samples"`
+}
+
+type EnergyPredictor interface {
+	Estimate(ctx context.Context, route *Route, busID *uint32) (*EnergyEstimate, error)
+}
+
+type EnergyUseCase struct {
+	predictor EnergyPredictor
+	routes    RouteRepo
+}
+
+func NewEnergyUseCase(predictor EnergyPredictor, routes RouteRepo) *EnergyUseCase {
+	return &EnergyUseCase{predictor: predictor, routes: routes}
+}
+
+func (uc *EnergyUseCase) Estimate(ctx context.Context, routeID uint32, busID *uint32) (*EnergyEstimate, error) {
+	route, err := uc.routes.GetById(ctx, routeID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.predictor.Estimate(ctx, route, busID)
+}
+
+// CheckBus возвращает предупреждение, если заряда автобуса не хватит на рейс.
+// Пустая строка - предупреждений нет или прогноз невозможен.
+func (uc *EnergyUseCase) CheckBus(ctx context.Context, bus *Bus) string {
+	if bus.RouteID == nil {
+		return ""
+	}
+	estimate, err := uc.Estimate(ctx, *bus.RouteID, &bus.Id)
+	if err != nil {
+		return ""
+	}
+	if float32(bus.BatteryLevel) >= estimate.Usage {
+		return ""
+	}
+	return fmt.Sprintf("LOW_BATTERY: заряд %d%%, на рейс нужно около %.0f%%", bus.BatteryLevel, estimate.Usage)
+}
@@ -0,0 +1,188 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	events "bus-service/api/events/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EventsExchange topic exchange доменных событий, ключ маршрутизации совпадает с типом события
+const EventsExchange = "fleet.events"
+
+// EventVersion версия схем api/events/v1
+const EventVersion = 1
+
+const (
+	EventBusCreated       = "bus.created"
+	EventBusStatusChanged = "bus.status_changed"
+	EventShiftStarted     = "shift.started"
+	EventShiftEnded       = "shift.ended"
+	EventRouteCreated     = "route.created"
+	EventRouteUpdated     = "route.updated"
+	EventRouteDeleted     = "route.deleted"
+	EventAccidentOpened   = "accident.opened"
+	EventAccidentClosed   = "accident.closed"
+)
+
+// Event записывает доменное событие в outbox транзакции из ctx
+func (o *Outbox) Event(ctx context.Context, event *events.Event) error {
+	event.Version = EventVersion
+	if event.Time == nil {
+		event.Time = timestamppb.Now()
+	}
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return o.repo.Create(ctx, &OutboxMessage{
+		Exchange:    EventsExchange,
+		RoutingKey:  event.Type,
+		ContentType: "application/json",
+		Body:        body,
+		CreatedAt:   time.Now(),
+	})
+}
+
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func busCreatedEvent(bus *BusDTO) *events.Event {
+	return &events.Event{
+		Type: EventBusCreated,
+		Payload: &events.Event_BusCreated{BusCreated: &events.BusCreated{
+			BusId:   bus.Id,
+			Number:  bus.Number,
+			RouteId: bus.RouteID,
+		}},
+	}
+}
+
+func busStatusChangedEvent(change *BusStatusChange, bus *BusDTO) *events.Event {
+	return &events.Event{
+		Type: EventBusStatusChanged,
+		Time: timestamppb.New(change.ChangedAt),
+		Payload: &events.Event_BusStatusChanged{BusStatusChanged: &events.BusStatusChanged{
+			BusId:     change.BusID,
+			From:      string(change.From),
+			To:        string(change.To),
+			ChangedBy: change.ChangedBy,
+			ChangedAt: timestamppb.New(change.ChangedAt),
+			RouteId:   bus.RouteID,
+			DriverId:  bus.DriverID,
+		}},
+	}
+}
+
+func shiftStartedEvent(shift *Shift) *events.Event {
+	return &events.Event{
+		Type: EventShiftStarted,
+		Time: timestamppb.New(shift.StartTime),
+		Payload: &events.Event_ShiftStarted{ShiftStarted: &events.ShiftStarted{
+			ShiftId:   shift.Id,
+			DriverId:  shift.DriverID,
+			BusId:     shift.BusID,
+			RouteId:   shift.RouteID,
+			StartTime: timestamppb.New(shift.StartTime),
+		}},
+	}
+}
+
+func shiftEndedEvent(shift *Shift) *events.Event {
+	return &events.Event{
+		Type: EventShiftEnded,
+		Time: timestampOrNil(shift.EndDate),
+		Payload: &events.Event_ShiftEnded{ShiftEnded: &events.ShiftEnded{
+			ShiftId:   shift.Id,
+			DriverId:  shift.DriverID,
+			BusId:     shift.BusID,
+			RouteId:   shift.RouteID,
+			StartTime: timestamppb.New(shift.StartTime),
+			EndTime:   timestampOrNil(shift.EndDate),
+			Overrun:   shift.Overrun,
+		}},
+	}
+}
+
+func routeStations(route *Route) []*events.RouteStation {
+	stations := make([]*events.RouteStation, 0, len(route.Stations))
+	for _, station := range route.Stations {
+		stations = append(stations, &events.RouteStation{
+			StationId: uint64(station.ID),
+			Name:      station.Name,
+			Lat:       station.Lat,
+			Lon:       station.Lon,
+		})
+	}
+	return stations
+}
+
+func routeCreatedEvent(route *Route) *events.Event {
+	return &events.Event{
+		Type: EventRouteCreated,
+		Payload: &events.Event_RouteCreated{RouteCreated: &events.RouteCreated{
+			RouteId:  route.Id,
+			Number:   route.Number,
+			Stations: routeStations(route),
+			Length:   route.Length,
+		}},
+	}
+}
+
+func routeUpdatedEvent(route *Route, stationsChanged bool) *events.Event {
+	return &events.Event{
+		Type: EventRouteUpdated,
+		Payload: &events.Event_RouteUpdated{RouteUpdated: &events.RouteUpdated{
+			RouteId:         route.Id,
+			Number:          route.Number,
+			Stations:        routeStations(route),
+			Length:          route.Length,
+			StationsChanged: stationsChanged,
+		}},
+	}
+}
+
+func routeDeletedEvent(id uint32) *events.Event {
+	return &events.Event{
+		Type:    EventRouteDeleted,
+		Payload: &events.Event_RouteDeleted{RouteDeleted: &events.RouteDeleted{RouteId: id}},
+	}
+}
+
+func accidentOpenedEvent(accident *Accident) *events.Event {
+	return &events.Event{
+		Type: EventAccidentOpened,
+		Time: timestamppb.New(accident.StartDate),
+		Payload: &events.Event_AccidentOpened{AccidentOpened: &events.AccidentOpened{
+			AccidentId: accident.Id,
+			Name:       accident.Name,
+			Lat:        accident.Lat,
+			Lon:        accident.Lon,
+			StartDate:  timestamppb.New(accident.StartDate),
+			RouteIds:   accident.RouteIDs,
+		}},
+	}
+}
+
+func accidentClosedEvent(accident *Accident) *events.Event {
+	return &events.Event{
+		Type: EventAccidentClosed,
+		Time: timestampOrNil(accident.EndDate),
+		Payload: &events.Event_AccidentClosed{AccidentClosed: &events.AccidentClosed{
+			AccidentId: accident.Id,
+			Name:       accident.Name,
+			Lat:        accident.Lat,
+			Lon:        accident.Lon,
+			StartDate:  timestamppb.New(accident.StartDate),
+			EndDate:    timestampOrNil(accident.EndDate),
+			RouteIds:   accident.RouteIDs,
+		}},
+	}
+}
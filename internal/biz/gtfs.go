@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"bus-service/pkg/gtfs"
+)
+
+// PathPrecision точность polyline в Route.Path, map-service отдает polyline6
+const PathPrecision = 6
+
+// GtfsAgency перевозчик для agency.txt
+type GtfsAgency struct {
+	Name     string
+	URL      string
+	Timezone string
+	// Location зона Timezone, в ней считаются сутки и время расписаний
+	Location *time.Location
+}
+
+type GtfsUseCase struct {
+	agency     *GtfsAgency
+	routes     RouteRepo
+	stations   StationRepo
+	timetables TimetableRepo
+	planner    *RouteUseCase
+	tx         Transaction
+	outbox     *Outbox
+	notifier   *Notifier
+}
+
+func NewGtfsUseCase(agency *GtfsAgency, routes RouteRepo, stations StationRepo, timetables TimetableRepo, planner *RouteUseCase, tx Transaction, outbox *Outbox, notifier *Notifier) *GtfsUseCase {
+	return &GtfsUseCase{agency: agency, routes: routes, stations: stations, timetables: timetables, planner: planner, tx: tx, outbox: outbox, notifier: notifier}
+}
+
+const gtfsAgencyID = "1"
+
+// Export собирает статический фид GTFS. Все таблицы упорядочены по
+// идентификаторам, поэтому одинаковые данные дают одинаковый архив.
+func (uc *GtfsUseCase) Export(ctx context.Context) (*gtfs.Feed, error) {
+	routes, _, err := uc.routes.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// список маршрутов уже содержит остановки в порядке следования
+	full := routes
+	sort.Slice(full, func(i, j int) bool { return full[i].Id < full[j].Id })
+	timetables, err := uc.timetables.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(timetables, func(i, j int) bool { return timetables[i].Id < timetables[j].Id })
+
+	feed := &gtfs.Feed{}
+	agency := feed.Add("agency.txt", "agency_id", "agency_name", "agency_url", "agency_timezone")
+	agency.Append(gtfsAgencyID, uc.agency.Name, uc.agency.URL, uc.agency.Timezone)
+
+	stops := feed.Add("stops.txt", "stop_id", "stop_name", "stop_lat", "stop_lon")
+	stations := map[uint]Stations{}
+	for _, route := range full {
+		for _, station := range route.Stations {
+			stations[station.ID] = station
+		}
+	}
+	stationIDs := make([]uint, 0, len(stations))
+	for id := range stations {
+		stationIDs = append(stationIDs, id)
+	}
+	sort.Slice(stationIDs, func(i, j int) bool { return stationIDs[i] < stationIDs[j] })
+	for _, id := range stationIDs {
+		station := stations[id]
+		stops.Append(gtfsID(uint32(id)), station.Name, coord(station.Lat), coord(station.Lon))
+	}
+
+	routesTable := feed.Add("routes.txt", "route_id", "agency_id", "route_short_name", "route_type")
+	shapes := feed.Add("shapes.txt", "shape_id", "shape_pt_lat", "shape_pt_lon", "shape_pt_sequence")
+	routeByID := map[uint32]*Route{}
+	for _, route := range full {
+		routeByID[route.Id] = route
+		// 3 автобус
+		routesTable.Append(gtfsID(route.Id), gtfsAgencyID, route.Number, "3")
+		points, err := gtfs.DecodePolyline(route.Path, PathPrecision)
+		if err != nil {
+			return nil, fmt.Errorf("route %d: %w", route.Id, err)
+		}
+		for i, point := range points {
+			shapes.Append(gtfsID(route.Id), coord(point.Lat), coord(point.Lon), strconv.Itoa(i))
+		}
+	}
+
+	calendar := feed.Add("calendar.txt", "service_id", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday", "start_date", "end_date")
+	trips := feed.Add("trips.txt", "route_id", "service_id", "trip_id", "shape_id")
+	stopTimes := feed.Add("stop_times.txt", "trip_id", "arrival_time", "departure_time", "stop_id", "stop_sequence")
+	for _, timetable := range timetables {
+		route, ok := routeByID[timetable.RouteID]
+		if !ok || len(route.Stations) < 2 {
+			continue
+		}
+		serviceID := gtfsID(timetable.Id)
+		// без геометрии у маршрута нет записей в shapes.txt
+		shapeID := ""
+		if route.Path != "" {
+			shapeID = gtfsID(route.Id)
+		}
+		days := []string{"0", "0", "0", "0", "0", "0", "0"}
+		for _, day := range timetable.ServiceDays {
+			days[day-1] = "1"
+		}
+		// расписания действуют бессрочно
+		calendar.Append(append(append([]string{serviceID}, days...), "20000101", "20991231")...)
+		for _, trip := range BuildTrips(timetable, route) {
+			tripID := fmt.Sprintf("%d_%d", timetable.Id, trip.Departure)
+			trips.Append(gtfsID(route.Id), serviceID, tripID, shapeID)
+			for i, arrival := range trip.StopTimes {
+				departure := arrival
+				if i > 0 && i < len(trip.StopTimes)-1 {
+					departure += ClockTime(timetable.Dwell)
+				}
+				stopTimes.Append(tripID, gtfs.Time(int(arrival)), gtfs.Time(int(departure)),
+					gtfsID(uint32(route.Stations[i].ID)), strconv.Itoa(i))
+			}
+		}
+	}
+	return feed, nil
+}
+
+func gtfsID(id uint32) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+func coord(value float64) string {
+	return strconv.FormatFloat(value, 'f', 6, 64)
+}
@@ -0,0 +1,436 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"bus-service/pkg/gtfs"
+)
+
+var ErrInvalidGtfs = errors.New("INVALID_GTFS")
+
+const (
+	GtfsActionCreate    = "create"
+	GtfsActionUpdate    = "update"
+	GtfsActionUnchanged = "unchanged"
+)
+
+// GtfsImportChange изменение одной сущности при импорте
+type GtfsImportChange struct {
+	Key     string   `json:"key"`
+	Action  string   `json:"action"`
+	Changes []string `json:"changes,omitempty"`
+	// Geometry источник геометрии маршрута: shape или map
+	Geometry string `json:"geometry,omitempty"`
+}
+
+type GtfsImportReport struct {
+	DryRun   bool                `json:"dry_run"`
+	Routes   []*GtfsImportChange `json:"routes"`
+	Stations []*GtfsImportChange `json:"stations"`
+}
+
+type gtfsStopTime struct {
+	stopID    string
+	sequence  int
+	arrival   int
+	departure int
+}
+
+// gtfsRoute маршрут из фида, собранный по самому длинному рейсу
+type gtfsRoute struct {
+	route           *Route
+	hasShape        bool
+	stationsChanged bool
+}
+
+// Import создает или обновляет маршруты и остановки из статического фида GTFS.
+// Остановки маршрута берутся из самого длинного рейса, геометрия из shapes.txt,
+// а при его отсутствии строится через map-service. При dryRun база не меняется.
+func (uc *GtfsUseCase) Import(ctx context.Context, data []byte, dryRun bool) (*GtfsImportReport, error) {
+	feed, err := gtfs.ReadZip(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidGtfs, err)
+	}
+	for _, name := range []string{"stops.txt", "routes.txt", "trips.txt", "stop_times.txt"} {
+		if feed.Table(name) == nil {
+			return nil, fmt.Errorf("%w: %s is missing", ErrInvalidGtfs, name)
+		}
+	}
+	stops, err := gtfsStops(feed)
+	if err != nil {
+		return nil, err
+	}
+	routes, err := gtfsRoutes(feed, stops)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &GtfsImportReport{DryRun: dryRun}
+	existingStations, _, err := uc.stations.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// остановки сопоставляются по stop_id, а остановки без него по названию и координатам
+	byGtfsID := map[string]*Stations{}
+	byKey := map[string]*Stations{}
+	for _, station := range existingStations {
+		if station.GtfsID != nil {
+			byGtfsID[*station.GtfsID] = station
+		}
+		byKey[stationKey(station)] = station
+	}
+	stopIDs := make([]string, 0, len(stops))
+	for id := range stops {
+		stopIDs = append(stopIDs, id)
+	}
+	sort.Strings(stopIDs)
+	// stationIDs id остановки в базе по stop_id, 0 для новых
+	stationIDs := map[string]uint{}
+	newStations := make([]*Stations, 0)
+	patches := make([]*StationsPatch, 0)
+	created := map[string]bool{}
+	for _, id := range stopIDs {
+		station := stops[id]
+		key := stationKey(station)
+		change := &GtfsImportChange{Key: station.Name, Action: GtfsActionUnchanged}
+		if existing, ok := byGtfsID[id]; ok {
+			stationIDs[id] = existing.ID
+			patch := &StationsPatch{ID: existing.ID}
+			if existing.Name != station.Name {
+				patch.Name = &station.Name
+				change.Changes = append(change.Changes, "name")
+			}
+			if existing.Lat != station.Lat || existing.Lon != station.Lon {
+				patch.Lat, patch.Lon = &station.Lat, &station.Lon
+				change.Changes = append(change.Changes, "location")
+			}
+			if len(change.Changes) > 0 {
+				change.Action = GtfsActionUpdate
+				patches = append(patches, patch)
+			}
+		} else if existing, ok := byKey[key]; ok {
+			// одна запись на место: другие stop_id с тем же местом ссылаются на нее
+			stationIDs[id] = existing.ID
+			if existing.GtfsID == nil {
+				gtfsID := id
+				existing.GtfsID = &gtfsID
+				change.Action = GtfsActionUpdate
+				change.Changes = []string{"gtfs_id"}
+				patches = append(patches, &StationsPatch{ID: existing.ID, GtfsID: &gtfsID})
+			}
+		} else if !created[key] {
+			created[key] = true
+			change.Action = GtfsActionCreate
+			newStations = append(newStations, station)
+		}
+		report.Stations = append(report.Stations, change)
+	}
+
+	existingRoutes, _, err := uc.routes.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	routeIDs := map[string]uint32{}
+	for _, route := range existingRoutes {
+		routeIDs[route.Number] = route.Id
+	}
+	changed := make([]*gtfsRoute, 0)
+	for _, imported := range routes {
+		route := imported.route
+		for i := range route.Stations {
+			route.Stations[i].ID = stationIDs[*route.Stations[i].GtfsID]
+		}
+		change := &GtfsImportChange{Key: route.Number, Action: GtfsActionCreate, Geometry: "map"}
+		if imported.hasShape {
+			change.Geometry = "shape"
+		}
+		if id, ok := routeIDs[route.Number]; ok {
+			current, err := uc.routes.GetById(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			route.Id = id
+			change.Changes = routeChanges(current, route, imported.hasShape)
+			imported.stationsChanged = len(change.Changes) > 0 && change.Changes[0] == "stations"
+			change.Action = GtfsActionUpdate
+			if len(change.Changes) == 0 {
+				change.Action = GtfsActionUnchanged
+			}
+		}
+		report.Routes = append(report.Routes, change)
+		if change.Action != GtfsActionUnchanged {
+			changed = append(changed, imported)
+		}
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	// map-service вызывается до транзакции, чтобы не держать ее на время запросов
+	for _, imported := range changed {
+		if !imported.hasShape {
+			if err := uc.planner.Plan(ctx, imported.route); err != nil {
+				return nil, fmt.Errorf("route %s: %w", imported.route.Number, err)
+			}
+		}
+	}
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		for _, patch := range patches {
+			if err := uc.stations.Update(ctx, patch); err != nil {
+				return err
+			}
+		}
+		for _, station := range newStations {
+			if err := uc.stations.Create(ctx, station); err != nil {
+				return err
+			}
+		}
+		// об измененных остановках social получает одно уведомление на весь импорт
+		notification := &Notification{Type: NotificationRouteChange}
+		for _, imported := range changed {
+			route := imported.route
+			if route.Id == 0 {
+				if err := uc.routes.Create(ctx, route); err != nil {
+					return fmt.Errorf("route %s: %w", route.Number, err)
+				}
+				if err := uc.outbox.Event(ctx, routeCreatedEvent(route)); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := uc.routes.Update(ctx, route); err != nil {
+				return fmt.Errorf("route %s: %w", route.Number, err)
+			}
+			if err := uc.outbox.Event(ctx, routeUpdatedEvent(route, imported.stationsChanged)); err != nil {
+				return err
+			}
+			if imported.stationsChanged {
+				notification.RouteIDs = append(notification.RouteIDs, route.Id)
+				notification.RouteNumbers = append(notification.RouteNumbers, route.Number)
+			}
+		}
+		if len(notification.RouteIDs) == 0 {
+			return nil
+		}
+		return uc.notifier.Publish(ctx, notification)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func stationKey(station *Stations) string {
+	return fmt.Sprintf("%s|%v|%v", station.Name, station.Lat, station.Lon)
+}
+
+// routeChanges поля маршрута, которые изменит импорт
+func routeChanges(current, next *Route, hasShape bool) []string {
+	changes := make([]string, 0)
+	if len(current.Stations) != len(next.Stations) {
+		changes = append(changes, "stations")
+	} else {
+		for i := range current.Stations {
+			if current.Stations[i].ID != next.Stations[i].ID ||
+				stationKey(&current.Stations[i]) != stationKey(&next.Stations[i]) {
+				changes = append(changes, "stations")
+				break
+			}
+		}
+	}
+	if hasShape && current.Path != next.Path {
+		changes = append(changes, "path")
+	}
+	if hasShape && !floatsEqual(current.Time, next.Time) {
+		changes = append(changes, "time")
+	}
+	return changes
+}
+
+func floatsEqual(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func gtfsStops(feed *gtfs.Feed) (map[string]*Stations, error) {
+	stops := map[string]*Stations{}
+	for _, record := range feed.Table("stops.txt").Records() {
+		// станции и входы (location_type 1..4) не являются остановками маршрута
+		if t := record["location_type"]; t != "" && t != "0" {
+			continue
+		}
+		lat, err := strconv.ParseFloat(record["stop_lat"], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: stop %s lat", ErrInvalidGtfs, record["stop_id"])
+		}
+		lon, err := strconv.ParseFloat(record["stop_lon"], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: stop %s lon", ErrInvalidGtfs, record["stop_id"])
+		}
+		stopID := record["stop_id"]
+		stops[stopID] = &Stations{Name: record["stop_name"], Lat: lat, Lon: lon, GtfsID: &stopID}
+	}
+	return stops, nil
+}
+
+func gtfsRoutes(feed *gtfs.Feed, stops map[string]*Stations) ([]*gtfsRoute, error) {
+	stopTimes := map[string][]gtfsStopTime{}
+	for _, record := range feed.Table("stop_times.txt").Records() {
+		sequence, err := strconv.Atoi(record["stop_sequence"])
+		if err != nil {
+			return nil, fmt.Errorf("%w: trip %s stop_sequence", ErrInvalidGtfs, record["trip_id"])
+		}
+		stopTime := gtfsStopTime{stopID: record["stop_id"], sequence: sequence, arrival: -1, departure: -1}
+		if value := record["arrival_time"]; value != "" {
+			if stopTime.arrival, err = gtfs.ParseTime(value); err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidGtfs, err)
+			}
+		}
+		if value := record["departure_time"]; value != "" {
+			if stopTime.departure, err = gtfs.ParseTime(value); err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidGtfs, err)
+			}
+		}
+		stopTimes[record["trip_id"]] = append(stopTimes[record["trip_id"]], stopTime)
+	}
+	for _, times := range stopTimes {
+		sort.Slice(times, func(i, j int) bool { return times[i].sequence < times[j].sequence })
+	}
+	shapes, err := gtfsShapes(feed)
+	if err != nil {
+		return nil, err
+	}
+
+	// самый длинный рейс маршрута, при равенстве с меньшим trip_id
+	type trip struct {
+		id      string
+		shapeID string
+	}
+	longest := map[string]trip{}
+	for _, record := range feed.Table("trips.txt").Records() {
+		routeID, tripID := record["route_id"], record["trip_id"]
+		best, ok := longest[routeID]
+		count, bestCount := len(stopTimes[tripID]), len(stopTimes[best.id])
+		if !ok || count > bestCount || (count == bestCount && tripID < best.id) {
+			longest[routeID] = trip{id: tripID, shapeID: record["shape_id"]}
+		}
+	}
+
+	result := make([]*gtfsRoute, 0)
+	numbers := map[string]bool{}
+	for _, record := range feed.Table("routes.txt").Records() {
+		number := record["route_short_name"]
+		if number == "" {
+			number = record["route_long_name"]
+		}
+		if number == "" {
+			number = record["route_id"]
+		}
+		best, ok := longest[record["route_id"]]
+		if !ok {
+			continue
+		}
+		// маршруты сопоставляются по номеру, поэтому номер в фиде должен быть уникальным
+		if numbers[number] {
+			return nil, fmt.Errorf("%w: duplicate route %s", ErrInvalidGtfs, number)
+		}
+		numbers[number] = true
+		route := &Route{Number: number}
+		// повторные заезды на остановку сохраняются, например на кольцевых маршрутах
+		var prev *gtfsStopTime
+		for i := range stopTimes[best.id] {
+			stopTime := stopTimes[best.id][i]
+			station, ok := stops[stopTime.stopID]
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown stop %s", ErrInvalidGtfs, stopTime.stopID)
+			}
+			if prev != nil {
+				leg := float32(0)
+				from := prev.departure
+				if from < 0 {
+					from = prev.arrival
+				}
+				if stopTime.arrival >= 0 && from >= 0 && stopTime.arrival > from {
+					leg = float32(stopTime.arrival - from)
+				}
+				route.Time = append(route.Time, leg)
+			}
+			route.Stations = append(route.Stations, *station)
+			prev = &stopTimes[best.id][i]
+		}
+		points, hasShape := shapes[best.shapeID]
+		if hasShape {
+			route.Path = gtfs.EncodePolyline(points, PathPrecision)
+			route.Length, route.Lengths = shapeLengths(points, route.Stations)
+		}
+		result = append(result, &gtfsRoute{route: route, hasShape: hasShape})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].route.Number < result[j].route.Number })
+	return result, nil
+}
+
+func gtfsShapes(feed *gtfs.Feed) (map[string][]gtfs.Point, error) {
+	type shapePoint struct {
+		sequence int
+		point    gtfs.Point
+	}
+	points := map[string][]shapePoint{}
+	for _, record := range feed.Table("shapes.txt").Records() {
+		sequence, err := strconv.Atoi(record["shape_pt_sequence"])
+		if err != nil {
+			return nil, fmt.Errorf("%w: shape %s sequence", ErrInvalidGtfs, record["shape_id"])
+		}
+		lat, err := strconv.ParseFloat(record["shape_pt_lat"], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: shape %s lat", ErrInvalidGtfs, record["shape_id"])
+		}
+		lon, err := strconv.ParseFloat(record["shape_pt_lon"], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: shape %s lon", ErrInvalidGtfs, record["shape_id"])
+		}
+		points[record["shape_id"]] = append(points[record["shape_id"]], shapePoint{sequence, gtfs.Point{Lat: lat, Lon: lon}})
+	}
+	shapes := map[string][]gtfs.Point{}
+	for id, shape := range points {
+		sort.Slice(shape, func(i, j int) bool { return shape[i].sequence < shape[j].sequence })
+		for _, p := range shape {
+			shapes[id] = append(shapes[id], p.point)
+		}
+	}
+	return shapes, nil
+}
+
+// shapeLengths длина формы и длины участков между остановками в метрах.
+// Участки считаются по прямой и масштабируются к длине формы.
+func shapeLengths(points []gtfs.Point, stations []Stations) (float32, []float32) {
+	var total float64
+	for i := 1; i < len(points); i++ {
+		total += haversine(points[i-1].Lat, points[i-1].Lon, points[i].Lat, points[i].Lon)
+	}
+	legs := make([]float64, 0, len(stations))
+	var direct float64
+	for i := 1; i < len(stations); i++ {
+		leg := haversine(stations[i-1].Lat, stations[i-1].Lon, stations[i].Lat, stations[i].Lon)
+		legs = append(legs, leg)
+		direct += leg
+	}
+	lengths := make([]float32, 0, len(legs))
+	for _, leg := range legs {
+		if direct > 0 {
+			leg = leg * total / direct
+		}
+		lengths = append(lengths, float32(leg*metersPerKm))
+	}
+	return float32(total * metersPerKm), lengths
+}
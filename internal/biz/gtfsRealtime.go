@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rt "bus-service/api/gtfsrt/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// livePositionAge отметки старше этого возраста не попадают в ленту
+const livePositionAge = 5 * time.Minute
+
+const gtfsRealtimeVersion = "2.0"
+
+type GtfsRealtimeUseCase struct {
+	positions PositionRepo
+	accidents AccidentRepo
+}
+
+func NewGtfsRealtimeUseCase(positions PositionRepo, accidents AccidentRepo) *GtfsRealtimeUseCase {
+	return &GtfsRealtimeUseCase{positions: positions, accidents: accidents}
+}
+
+func feedHeader(now time.Time) *rt.FeedHeader {
+	return &rt.FeedHeader{
+		GtfsRealtimeVersion: proto.String(gtfsRealtimeVersion),
+		Incrementality:      rt.FeedHeader_FULL_DATASET.Enum(),
+		Timestamp:           proto.Uint64(uint64(now.Unix())),
+	}
+}
+
+// VehiclePositions положения автобусов в работе. Идентификаторы маршрутов
+// совпадают со статическим фидом
+func (uc *GtfsRealtimeUseCase) VehiclePositions(ctx context.Context) (*rt.FeedMessage, error) {
+	now := time.Now()
+	states, err := uc.positions.Live(ctx, now.Add(-livePositionAge))
+	if err != nil {
+		return nil, err
+	}
+	feed := &rt.FeedMessage{Header: feedHeader(now)}
+	for _, state := range states {
+		if state.Status != BusStatusInService {
+			continue
+		}
+		position := state.Position
+		vehicle := &rt.VehiclePosition{
+			Vehicle: &rt.VehicleDescriptor{
+				Id:    proto.String(gtfsID(position.BusID)),
+				Label: proto.String(state.Number),
+			},
+			Position: &rt.Position{
+				Latitude:  proto.Float32(float32(position.Lat)),
+				Longitude: proto.Float32(float32(position.Lon)),
+				Bearing:   proto.Float32(position.Heading),
+				Speed:     proto.Float32(position.Speed),
+			},
+			Timestamp: proto.Uint64(uint64(position.Timestamp.Unix())),
+		}
+		if state.RouteID != nil {
+			vehicle.Trip = &rt.TripDescriptor{RouteId: proto.String(gtfsID(*state.RouteID))}
+		}
+		feed.Entity = append(feed.Entity, &rt.FeedEntity{
+			Id:      proto.String(fmt.Sprintf("vehicle-%d", position.BusID)),
+			Vehicle: vehicle,
+		})
+	}
+	return feed, nil
+}
+
+// ServiceAlerts оповещения по активным ДТП на маршрутах
+func (uc *GtfsRealtimeUseCase) ServiceAlerts(ctx context.Context) (*rt.FeedMessage, error) {
+	accidents, err := uc.accidents.List(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	feed := &rt.FeedMessage{Header: feedHeader(time.Now())}
+	for _, accident := range accidents {
+		if len(accident.RouteIDs) == 0 {
+			continue
+		}
+		period := &rt.TimeRange{}
+		if !accident.StartDate.IsZero() {
+			period.Start = proto.Uint64(uint64(accident.StartDate.Unix()))
+		}
+		if accident.EndDate != nil {
+			period.End = proto.Uint64(uint64(accident.EndDate.Unix()))
+		}
+		alert := &rt.Alert{
+			ActivePeriod: []*rt.TimeRange{period},
+			Cause:        rt.Alert_ACCIDENT.Enum(),
+			Effect:       rt.Alert_SIGNIFICANT_DELAYS.Enum(),
+			HeaderText:   translated("ru", "ДТП на маршруте", "en", "Road accident on route"),
+			DescriptionText: translated("ru", accident.Name,
+				"en", fmt.Sprintf("Accident at %.5f, %.5f", accident.Lat, accident.Lon)),
+		}
+		for _, routeID := range accident.RouteIDs {
+			alert.InformedEntity = append(alert.InformedEntity, &rt.EntitySelector{
+				RouteId: proto.String(gtfsID(routeID)),
+			})
+		}
+		feed.Entity = append(feed.Entity, &rt.FeedEntity{
+			Id:    proto.String(fmt.Sprintf("accident-%d", accident.Id)),
+			Alert: alert,
+		})
+	}
+	return feed, nil
+}
+
+// translated строка с переводами, пары язык-текст
+func translated(pairs ...string) *rt.TranslatedString {
+	result := &rt.TranslatedString{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			continue
+		}
+		result.Translation = append(result.Translation, &rt.TranslatedString_Translation{
+			Language: proto.String(pairs[i]),
+			Text:     proto.String(pairs[i+1]),
+		})
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NotificationAccident        NotificationType = "accident"
+	NotificationAccidentCleared NotificationType = "accident_cleared"
+	NotificationRouteChange     NotificationType = "route_change"
+	NotificationBusBreakdown    NotificationType = "bus_breakdown"
+)
+
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// DefaultLocale язык поля Message
+const DefaultLocale = "ru"
+
+// Notification сообщение для очереди social. Кроме готовых текстов на всех языках
+// содержит данные события, чтобы каналы могли собрать свой формат
+type Notification struct {
+	Type         NotificationType `json:"type"`
+	Severity     Severity         `json:"severity"`
+	RouteIDs     []uint32         `json:"route_ids"`
+	RouteNumbers []string         `json:"route_numbers"`
+	AccidentID   *uint64          `json:"accident_id,omitempty"`
+	Lat          *float64         `json:"lat,omitempty"`
+	Lon          *float64         `json:"lon,omitempty"`
+	BusID        *uint32          `json:"bus_id,omitempty"`
+	BusNumber    string           `json:"bus_number,omitempty"`
+	Time         time.Time        `json:"time"`
+	// Message текст на DefaultLocale, поле прежнего формата сообщения
+	Message string            `json:"message"`
+	Texts   map[string]string `json:"texts"`
+}
+
+var notificationSeverity = map[NotificationType]Severity{
+	NotificationAccident:        SeverityWarning,
+	NotificationAccidentCleared: SeverityInfo,
+	NotificationRouteChange:     SeverityInfo,
+	NotificationBusBreakdown:    SeverityWarning,
+}
+
+// notificationTemplates шаблоны текстов по типу события и языку
+var notificationTemplates = map[NotificationType]map[string]string{
+	NotificationAccident: {
+		"ru": `Обнаружено ДТП на {{routes "маршруте" "маршрутах"}} {{join .RouteNumbers}}, извините за ожидание автобуса`,
+		"en": `Road accident on {{routes "route" "routes"}} {{join .RouteNumbers}}, buses may be delayed`,
+	},
+	NotificationAccidentCleared: {
+		"ru": `Движение по {{routes "маршруту" "маршрутам"}} {{join .RouteNumbers}} восстановлено`,
+		"en": `Service on {{routes "route" "routes"}} {{join .RouteNumbers}} has been restored`,
+	},
+	NotificationRouteChange: {
+		"ru": `Изменилась схема {{routes "маршрута" "маршрутов"}} {{join .RouteNumbers}}, проверьте остановки`,
+		"en": `{{routes "Route" "Routes"}} {{join .RouteNumbers}} changed, please check the stops`,
+	},
+	NotificationBusBreakdown: {
+		"ru": `Автобус {{.BusNumber}} на {{routes "маршруте" "маршрутах"}} {{join .RouteNumbers}} сошел с линии, возможны увеличенные интервалы`,
+		"en": `Bus {{.BusNumber}} on {{routes "route" "routes"}} {{join .RouteNumbers}} is out of service, expect longer intervals`,
+	},
+}
+
+type Notifier struct {
+	outbox    *Outbox
+	templates map[NotificationType]map[string]*template.Template
+}
+
+func NewNotifier(outbox *Outbox) *Notifier {
+	templates := map[NotificationType]map[string]*template.Template{}
+	for kind, locales := range notificationTemplates {
+		templates[kind] = map[string]*template.Template{}
+		for locale, text := range locales {
+			templates[kind][locale] = template.Must(template.New(string(kind) + "." + locale).Funcs(notificationFuncs).Parse(text))
+		}
+	}
+	return &Notifier{outbox: outbox, templates: templates}
+}
+
+var notificationFuncs = template.FuncMap{
+	"join": func(values []string) string {
+		return strings.Join(values, ", ")
+	},
+	// routes заменяется при рендеринге на форму с учетом числа маршрутов
+	"routes": func(one, many string) string {
+		return one
+	},
+}
+
+// Render заполняет Severity, Texts и Message по шаблонам типа уведомления
+func (n *Notifier) Render(notification *Notification) error {
+	if notification.Severity == "" {
+		notification.Severity = notificationSeverity[notification.Type]
+	}
+	if notification.Time.IsZero() {
+		notification.Time = time.Now()
+	}
+	plural := func(one, many string) string {
+		if len(notification.RouteNumbers) > 1 {
+			return many
+		}
+		return one
+	}
+	locales := make([]string, 0, len(n.templates[notification.Type]))
+	for locale := range n.templates[notification.Type] {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	notification.Texts = map[string]string{}
+	for _, locale := range locales {
+		var buf bytes.Buffer
+		tmpl, err := n.templates[notification.Type][locale].Clone()
+		if err != nil {
+			return err
+		}
+		if err := tmpl.Funcs(template.FuncMap{"routes": plural}).Execute(&buf, notification); err != nil {
+			return err
+		}
+		notification.Texts[locale] = buf.String()
+	}
+	notification.Message = notification.Texts[DefaultLocale]
+	return nil
+}
+
+// Publish рендерит уведомление и записывает его в outbox для social
+func (n *Notifier) Publish(ctx context.Context, notification *Notification) error {
+	if err := n.Render(notification); err != nil {
+		return err
+	}
+	return n.outbox.Add(ctx, "social", notification)
+}
@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// OutboxMessage сообщение для RabbitMQ, записанное в одной транзакции с изменением данных
+type OutboxMessage struct {
+	Id          uint64
+	Exchange    string
+	RoutingKey  string
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	SentAt      *time.Time
+	Attempts    int
+	LastError   string
+}
+
+type OutboxRepo interface {
+	Create(context.Context, *OutboxMessage) error
+	// Pending блокирует до limit неотправленных сообщений до конца транзакции,
+	// сообщения, заблокированные другим экземпляром сервиса, пропускаются
+	Pending(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	MarkSent(ctx context.Context, ids []uint64, at time.Time) error
+	MarkFailed(ctx context.Context, id uint64, reason string) error
+	// Purge удаляет отправленные сообщения старше before
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OutboxPublisher отправляет сообщения в брокер с подтверждением
+type OutboxPublisher interface {
+	// Publish возвращает ошибку по каждому сообщению, nil если брокер подтвердил прием
+	Publish(context.Context, []*OutboxMessage) []error
+}
+
+type Outbox struct {
+	repo      OutboxRepo
+	publisher OutboxPublisher
+	tx        Transaction
+}
+
+func NewOutbox(repo OutboxRepo, publisher OutboxPublisher, tx Transaction) *Outbox {
+	return &Outbox{repo: repo, publisher: publisher, tx: tx}
+}
+
+// Add ставит сообщение v в очередь queue. Запись идет в транзакцию из ctx,
+// поэтому сообщение уходит только если изменение данных сохранено
+func (o *Outbox) Add(ctx context.Context, queue string, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return o.repo.Create(ctx, &OutboxMessage{
+		RoutingKey:  queue,
+		ContentType: "application/json",
+		Body:        body,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// Relay отправляет очередную пачку сообщений и возвращает число отправленных.
+// Неподтвержденные сообщения остаются в outbox и уходят при следующем вызове,
+// поэтому доставка не реже одного раза и получатели должны учитывать повторы
+func (o *Outbox) Relay(ctx context.Context, limit int) (int, error) {
+	sent := 0
+	err := o.tx.ExecTx(ctx, func(ctx context.Context) error {
+		messages, err := o.repo.Pending(ctx, limit)
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+		errs := o.publisher.Publish(ctx, messages)
+		ids := make([]uint64, 0, len(messages))
+		for i, message := range messages {
+			if errs[i] != nil {
+				if err := o.repo.MarkFailed(ctx, message.Id, errs[i].Error()); err != nil {
+					return err
+				}
+				continue
+			}
+			ids = append(ids, message.Id)
+		}
+		sent = len(ids)
+		if sent == 0 {
+			return nil
+		}
+		return o.repo.MarkSent(ctx, ids, time.Now())
+	})
+	return sent, err
+}
+
+func (o *Outbox) Purge(ctx context.Context, before time.Time) (int64, error) {
+	return o.repo.Purge(ctx, before)
+}
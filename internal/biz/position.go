@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// BusPosition GPS-отметка автобуса
+type BusPosition struct {
+	BusID     uint32    `json:"bus_id"`
+	RouteID   *uint32   `json:"route_id,omitempty"`
+	Lat       float64   `json:"lat"`
+	Lon       float64   `json:"lon"`
+	Speed     float32   `json:"speed"`
+	Heading   float32   `json:"heading"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// VehicleState последнее положение автобуса вместе с его текущим назначением
+type VehicleState struct {
+	Position *BusPosition
+	Number   string
+	Status   BusStatus
+	// RouteID маршрут, на который автобус назначен сейчас
+	RouteID *uint32
+}
+
+type PositionRepo interface {
+	// Save сохраняет отметку в историю и обновляет последнее положение автобуса
+	Save(context.Context, *BusPosition) error
+	GetByBusID(context.Context, uint32) (*BusPosition, error)
+	List(context.Context) ([]*BusPosition, error)
+	// History отметки автобуса за период в порядке времени
+	History(ctx context.Context, busID uint32, from, to time.Time) ([]*BusPosition, error)
+	// Live автобусы с отметкой не старше since
+	Live(ctx context.Context, since time.Time) ([]*VehicleState, error)
+}
+
+type PositionUseCase struct {
+	repo   PositionRepo
+	buses  BusRepo
+	roles  RoleChecker
+	stream *FleetStream
+	logger *log.Helper
+}
+
+var (
+	ErrInvalidPosition   = errors.New("INVALID_POSITION")
+	ErrPositionForbidden = errors.New("POSITION_FORBIDDEN")
+)
+
+func NewPositionUseCase(repo PositionRepo, buses BusRepo, roles RoleChecker, stream *FleetStream, logger log.Logger) *PositionUseCase {
+	return &PositionUseCase{repo: repo, buses: buses, roles: roles, stream: stream, logger: log.NewHelper(logger)}
+}
+
+// Report принимает отметку из очереди telemetry, источник считается доверенным
+func (uc *PositionUseCase) Report(ctx context.Context, position *BusPosition) error {
+	return uc.report(ctx, position, "")
+}
+
+// ReportFrom принимает отметку от пользователя: водителя автобуса или диспетчера
+func (uc *PositionUseCase) ReportFrom(ctx context.Context, position *BusPosition, userID string) error {
+	return uc.report(ctx, position, userID)
+}
+
+func (uc *PositionUseCase) report(ctx context.Context, position *BusPosition, userID string) error {
+	if position.Lat < -90 || position.Lat > 90 || position.Lon < -180 || position.Lon > 180 {
+		return ErrInvalidPosition
+	}
+	if position.Timestamp.IsZero() {
+		position.Timestamp = time.Now()
+	}
+	routeID, driverID, err := uc.buses.RouteAndDriver(ctx, position.BusID)
+	if err != nil {
+		return err
+	}
+	if userID != "" && (driverID == nil || *driverID != userID) {
+		allowed, err := uc.roles.HasRealmRole(userID, DispatcherRole)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return ErrPositionForbidden
+		}
+	}
+	position.RouteID = routeID
+	if err := uc.repo.Save(ctx, position); err != nil {
+		return err
+	}
+	uc.stream.Publish(FleetEvent{
+		Type:     FleetEventPosition,
+		RouteIDs: routeIDs(position.RouteID),
+		Data:     position,
+		Time:     position.Timestamp,
+	})
+	return nil
+}
+
+func (uc *PositionUseCase) GetByBusID(ctx context.Context, busID uint32) (*BusPosition, error) {
+	return uc.repo.GetByBusID(ctx, busID)
+}
+
+func (uc *PositionUseCase) List(ctx context.Context) ([]*BusPosition, error) {
+	return uc.repo.List(ctx)
+}
@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	ErrRegulationMinRest   = errors.New("REGULATION_MIN_REST")
+	ErrRegulationDailyCap  = errors.New("REGULATION_DAILY_CAP")
+	ErrRegulationWeeklyCap = errors.New("REGULATION_WEEKLY_CAP")
+)
+
+// Regulation ограничения рабочего времени водителя, нулевое значение отключает правило
+type Regulation struct {
+	MaxShift  time.Duration
+	MinRest   time.Duration
+	DailyCap  time.Duration
+	WeeklyCap time.Duration
+	// MaxBreak перерыв короче MaxBreak (зарядка, обед, пересадка на другой автобус)
+	// не прерывает рабочий период, MinRest считается только между периодами
+	MaxBreak time.Duration
+	// AutoClose закрывать смены, превысившие MaxShift, иначе только помечать
+	AutoClose bool
+}
+
+// ShiftOverrunEvent публикуется в очередь regulation при превышении длительности смены
+type ShiftOverrunEvent struct {
+	ShiftID   uint32    `json:"shift_id"`
+	DriverID  string    `json:"driver_id"`
+	BusID     *uint32   `json:"bus_id,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	Limit     string    `json:"limit"`
+	Closed    bool      `json:"closed"`
+}
+
+// checkRegulation проверяет, может ли водитель начать смену в момент now
+func (uc *ShiftUseCase) checkRegulation(ctx context.Context, driverID string, now time.Time) error {
+	if uc.rules.MinRest > 0 {
+		restFrom := now.Add(-uc.rules.MinRest)
+		shifts, err := uc.repo.List(ctx, driverID, &restFrom, nil)
+		if err != nil {
+			return err
+		}
+		if err := checkRest(shifts, now, uc.rules.MinRest, uc.rules.MaxBreak); err != nil {
+			return err
+		}
+	}
+	if uc.rules.DailyCap > 0 {
+		y, m, d := now.Date()
+		dayStart := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+		if err := uc.checkCap(ctx, driverID, dayStart, now, uc.rules.DailyCap, ErrRegulationDailyCap); err != nil {
+			return err
+		}
+	}
+	if uc.rules.WeeklyCap > 0 {
+		y, m, d := now.Date()
+		// неделя начинается с понедельника
+		offset := (int(now.Weekday()) + 6) % 7
+		weekStart := time.Date(y, m, d-offset, 0, 0, 0, 0, now.Location())
+		if err := uc.checkCap(ctx, driverID, weekStart, now, uc.rules.WeeklyCap, ErrRegulationWeeklyCap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workPeriod непрерывная работа водителя из одной или нескольких смен
+type workPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// workPeriods сливает закрытые смены, между которыми перерыв короче maxBreak,
+// в рабочие периоды по возрастанию начала
+func workPeriods(shifts []*Shift, maxBreak time.Duration) []workPeriod {
+	closed := make([]*Shift, 0, len(shifts))
+	for _, shift := range shifts {
+		if shift.EndDate != nil {
+			closed = append(closed, shift)
+		}
+	}
+	sort.Slice(closed, func(i, j int) bool { return closed[i].StartTime.Before(closed[j].StartTime) })
+	periods := make([]workPeriod, 0, len(closed))
+	for _, shift := range closed {
+		last := len(periods) - 1
+		if last >= 0 && shift.StartTime.Sub(periods[last].End) < maxBreak {
+			if shift.EndDate.After(periods[last].End) {
+				periods[last].End = *shift.EndDate
+			}
+			continue
+		}
+		periods = append(periods, workPeriod{Start: shift.StartTime, End: *shift.EndDate})
+	}
+	return periods
+}
+
+// checkRest проверяет отдых перед сменой в момент now. Смена после перерыва
+// короче maxBreak продолжает последний рабочий период, иначе с его окончания
+// должно пройти не меньше minRest
+func checkRest(shifts []*Shift, now time.Time, minRest, maxBreak time.Duration) error {
+	periods := workPeriods(shifts, maxBreak)
+	if len(periods) == 0 {
+		return nil
+	}
+	rest := now.Sub(periods[len(periods)-1].End)
+	if rest < maxBreak || rest >= minRest {
+		return nil
+	}
+	return fmt.Errorf("%w: rested %s of required %s", ErrRegulationMinRest, rest.Truncate(time.Minute), minRest)
+}
+
+func (uc *ShiftUseCase) checkCap(ctx context.Context, driverID string, from, to time.Time, limit time.Duration, code error) error {
+	sheet, err := uc.Timesheet(ctx, driverID, &from, &to)
+	if err != nil {
+		return err
+	}
+	worked := time.Duration(sheet.TotalHours * float64(time.Hour))
+	if worked >= limit {
+		return fmt.Errorf("%w: worked %s of allowed %s", code, worked.Truncate(time.Minute), limit)
+	}
+	return nil
+}
+
+// CheckOverruns находит открытые смены дольше MaxShift, помечает их и при AutoClose закрывает
+func (uc *ShiftUseCase) CheckOverruns(ctx context.Context) error {
+	if uc.rules.MaxShift <= 0 {
+		return nil
+	}
+	shifts, err := uc.repo.ListOverrun(ctx, time.Now().Add(-uc.rules.MaxShift))
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, shift := range shifts {
+		if err := uc.overrun(ctx, shift); err != nil {
+			uc.logger.Errorf("shift %d overrun: %s", shift.Id, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d overrun shifts not processed", failed, len(shifts))
+	}
+	return nil
+}
+
+func (uc *ShiftUseCase) overrun(ctx context.Context, shift *Shift) error {
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		// помечаем до закрытия, чтобы событие shift.ended несло признак превышения.
+		// Update перезаписывает смену целиком, поэтому флаг ставится и в памяти
+		if err := uc.repo.MarkOverrun(ctx, shift.Id); err != nil {
+			return err
+		}
+		shift.Overrun = true
+		if uc.rules.AutoClose {
+			if err := uc.closeOverrun(ctx, shift); err != nil {
+				return err
+			}
+		}
+		return uc.publishOverrun(ctx, shift)
+	})
+}
+
+func (uc *ShiftUseCase) closeOverrun(ctx context.Context, shift *Shift) error {
+	if shift.BusID != nil {
+		bus, err := uc.buses.GetByIdForUpdate(ctx, *shift.BusID)
+		if err != nil {
+			return err
+		}
+		if bus.DriverID != nil && *bus.DriverID == shift.DriverID {
+			return uc.Release(ctx, bus.Id, regulationUser)
+		}
+	}
+	endTime := time.Now()
+	shift.EndDate = &endTime
+	if err := uc.repo.Update(ctx, shift); err != nil {
+		return err
+	}
+	return uc.outbox.Event(ctx, shiftEndedEvent(shift))
+}
+
+// regulationUser автор изменений, сделанных проверкой рабочего времени
+const regulationUser = "regulation"
+
+func (uc *ShiftUseCase) publishOverrun(ctx context.Context, shift *Shift) error {
+	return uc.outbox.Add(ctx, "regulation", &ShiftOverrunEvent{
+		ShiftID:   shift.Id,
+		DriverID:  shift.DriverID,
+		BusID:     shift.BusID,
+		StartTime: shift.StartTime,
+		Limit:     uc.rules.MaxShift.String(),
+		Closed:    uc.rules.AutoClose,
+	})
+}
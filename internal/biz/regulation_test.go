@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWorkPeriods(t *testing.T) {
+	day := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 10, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		shifts   []*Shift
+		maxBreak time.Duration
+		want     []workPeriod
+	}{
+		{
+			name:     "no shifts",
+			maxBreak: 2 * time.Hour,
+			want:     []workPeriod{},
+		},
+		{
+			name:     "open shift is skipped",
+			shifts:   []*Shift{{DriverID: "driver", StartTime: day(8, 0)}},
+			maxBreak: 2 * time.Hour,
+			want:     []workPeriod{},
+		},
+		{
+			name: "bus change merges into one period",
+			shifts: []*Shift{
+				closedShift(day(12, 30), day(16, 0)),
+				closedShift(day(8, 0), day(12, 0)),
+			},
+			maxBreak: 2 * time.Hour,
+			want:     []workPeriod{{Start: day(8, 0), End: day(16, 0)}},
+		},
+		{
+			name: "long break splits periods",
+			shifts: []*Shift{
+				closedShift(day(6, 0), day(9, 0)),
+				closedShift(day(14, 0), day(18, 0)),
+			},
+			maxBreak: 2 * time.Hour,
+			want:     []workPeriod{{Start: day(6, 0), End: day(9, 0)}, {Start: day(14, 0), End: day(18, 0)}},
+		},
+		{
+			name: "zero break keeps every shift separate",
+			shifts: []*Shift{
+				closedShift(day(8, 0), day(12, 0)),
+				closedShift(day(12, 30), day(16, 0)),
+			},
+			want: []workPeriod{{Start: day(8, 0), End: day(12, 0)}, {Start: day(12, 30), End: day(16, 0)}},
+		},
+		{
+			name: "nested shift does not shorten period",
+			shifts: []*Shift{
+				closedShift(day(8, 0), day(16, 0)),
+				closedShift(day(9, 0), day(10, 0)),
+			},
+			maxBreak: 2 * time.Hour,
+			want:     []workPeriod{{Start: day(8, 0), End: day(16, 0)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := workPeriods(tt.shifts, tt.maxBreak)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || !got[i].End.Equal(tt.want[i].End) {
+					t.Errorf("period %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckRest(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	// утренняя смена и продолжение на другом автобусе после пересадки
+	workday := []*Shift{
+		closedShift(at(10, 8, 0), at(10, 12, 0)),
+		closedShift(at(10, 12, 30), at(10, 16, 0)),
+	}
+	tests := []struct {
+		name     string
+		shifts   []*Shift
+		now      time.Time
+		maxBreak time.Duration
+		err      error
+	}{
+		{"first shift", nil, at(10, 8, 0), 2 * time.Hour, nil},
+		{"bus change after short break", workday[:1], at(10, 12, 30), 2 * time.Hour, nil},
+		{"bus change without max break", workday[:1], at(10, 12, 30), 0, ErrRegulationMinRest},
+		{"short break after merged period", workday, at(10, 16, 45), 2 * time.Hour, nil},
+		{"not enough rest", workday, at(10, 20, 0), 2 * time.Hour, ErrRegulationMinRest},
+		{"rested after merged period", workday, at(11, 3, 0), 2 * time.Hour, nil},
+		{"rest measured from period end", workday, at(11, 2, 59), 2 * time.Hour, ErrRegulationMinRest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRest(tt.shifts, tt.now, 11*time.Hour, tt.maxBreak)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCheckRegulation(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	useLocal(t, msk)
+	// 2024-01-08 понедельник
+	at := func(day, hour int) time.Time {
+		return time.Date(2024, 1, day, hour, 0, 0, 0, msk)
+	}
+	tests := []struct {
+		name   string
+		rules  Regulation
+		shifts []*Shift
+		now    time.Time
+		err    error
+	}{
+		{
+			name:   "rules disabled",
+			shifts: []*Shift{closedShift(at(8, 8), at(8, 20))},
+			now:    at(8, 21),
+		},
+		{
+			name:   "min rest",
+			rules:  Regulation{MinRest: 11 * time.Hour, MaxBreak: 2 * time.Hour},
+			shifts: []*Shift{closedShift(at(8, 6), at(8, 14))},
+			now:    at(8, 18),
+			err:    ErrRegulationMinRest,
+		},
+		{
+			name:   "under daily cap",
+			rules:  Regulation{DailyCap: 9 * time.Hour},
+			shifts: []*Shift{closedShift(at(8, 6), at(8, 14))},
+			now:    at(8, 15),
+		},
+		{
+			name:  "daily cap reached",
+			rules: Regulation{DailyCap: 9 * time.Hour},
+			shifts: []*Shift{
+				closedShift(at(8, 6), at(8, 11)),
+				closedShift(at(8, 12), at(8, 16)),
+			},
+			now: at(8, 17),
+			err: ErrRegulationDailyCap,
+		},
+		{
+			name:   "daily cap counts only today",
+			rules:  Regulation{DailyCap: 9 * time.Hour},
+			shifts: []*Shift{closedShift(at(7, 20), at(8, 4))},
+			now:    at(8, 10),
+		},
+		{
+			name:  "weekly cap reached",
+			rules: Regulation{WeeklyCap: 40 * time.Hour},
+			shifts: []*Shift{
+				closedShift(at(8, 6), at(8, 16)),
+				closedShift(at(9, 6), at(9, 16)),
+				closedShift(at(10, 6), at(10, 16)),
+				closedShift(at(11, 6), at(11, 16)),
+			},
+			now: at(12, 6),
+			err: ErrRegulationWeeklyCap,
+		},
+		{
+			name:  "weekly cap starts on monday",
+			rules: Regulation{WeeklyCap: 40 * time.Hour},
+			shifts: []*Shift{
+				closedShift(at(4, 6), at(4, 16)),
+				closedShift(at(5, 6), at(5, 16)),
+				closedShift(at(6, 6), at(6, 16)),
+				closedShift(at(7, 6), at(7, 16)),
+			},
+			now: at(8, 6),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &ShiftUseCase{repo: &shiftRepoStub{shifts: tt.shifts}, rules: &tt.rules}
+			err := uc.checkRegulation(context.Background(), "driver", tt.now)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidAssignmentPeriod = errors.New("INVALID_ASSIGNMENT_PERIOD")
+	ErrAssignmentOverlap       = errors.New("ASSIGNMENT_OVERLAP")
+	ErrAssignmentMismatch      = errors.New("ASSIGNMENT_MISMATCH")
+)
+
+// rosterTolerance насколько раньше плана водитель может начать смену
+const rosterTolerance = 30 * time.Minute
+
+// Assignment плановое назначение водителя на автобус и маршрут
+type Assignment struct {
+	Id           uint32    `json:"id"`
+	DriverID     string    `json:"driver_id"`
+	BusID        uint32    `json:"bus_id"`
+	RouteID      *uint32   `json:"route_id,omitempty"`
+	PlannedStart time.Time `json:"planned_start"`
+	PlannedEnd   time.Time `json:"planned_end"`
+}
+
+type AssignmentFilter struct {
+	DriverID *string
+	BusID    *uint32
+	From     *time.Time
+	To       *time.Time
+}
+
+type RosterRepo interface {
+	Create(context.Context, *Assignment) error
+	Update(context.Context, *Assignment) error
+	Delete(context.Context, uint32) error
+	GetById(context.Context, uint32) (*Assignment, error)
+	// List назначения, пересекающиеся с периодом фильтра
+	List(context.Context, *AssignmentFilter) ([]*Assignment, error)
+	// Next ближайшее назначение водителя, которое еще не закончилось к after
+	Next(ctx context.Context, driverID string, after time.Time) (*Assignment, error)
+}
+
+type RosterUseCase struct {
+	repo RosterRepo
+}
+
+func NewRosterUseCase(repo RosterRepo) *RosterUseCase {
+	return &RosterUseCase{repo: repo}
+}
+
+func (uc *RosterUseCase) Create(ctx context.Context, assignment *Assignment) error {
+	if err := uc.validate(ctx, assignment); err != nil {
+		return err
+	}
+	return uc.repo.Create(ctx, assignment)
+}
+
+func (uc *RosterUseCase) Update(ctx context.Context, assignment *Assignment) error {
+	if _, err := uc.repo.GetById(ctx, assignment.Id); err != nil {
+		return err
+	}
+	if err := uc.validate(ctx, assignment); err != nil {
+		return err
+	}
+	return uc.repo.Update(ctx, assignment)
+}
+
+func (uc *RosterUseCase) Delete(ctx context.Context, id uint32) error {
+	return uc.repo.Delete(ctx, id)
+}
+
+func (uc *RosterUseCase) GetById(ctx context.Context, id uint32) (*Assignment, error) {
+	return uc.repo.GetById(ctx, id)
+}
+
+func (uc *RosterUseCase) List(ctx context.Context, filter *AssignmentFilter) ([]*Assignment, error) {
+	return uc.repo.List(ctx, filter)
+}
+
+func (uc *RosterUseCase) Next(ctx context.Context, driverID string) (*Assignment, error) {
+	return uc.repo.Next(ctx, driverID, time.Now())
+}
+
+// validate проверяет период и что ни водитель, ни автобус не назначены дважды на одно время
+func (uc *RosterUseCase) validate(ctx context.Context, assignment *Assignment) error {
+	if !assignment.PlannedEnd.After(assignment.PlannedStart) {
+		return ErrInvalidAssignmentPeriod
+	}
+	filters := []*AssignmentFilter{
+		{DriverID: &assignment.DriverID},
+		{BusID: &assignment.BusID},
+	}
+	for _, filter := range filters {
+		filter.From = &assignment.PlannedStart
+		filter.To = &assignment.PlannedEnd
+		overlaps, err := uc.repo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, other := range overlaps {
+			if other.Id != assignment.Id {
+				return fmt.Errorf("%w: assignment %d", ErrAssignmentOverlap, other.Id)
+			}
+		}
+	}
+	return nil
+}
+
+// Check сверяет начало смены с графиком. Назначение водителя на другой автобус
+// блокирует старт, отсутствие назначения возвращает предупреждение.
+func (uc *RosterUseCase) Check(ctx context.Context, driverID string, busID uint32, now time.Time) (string, error) {
+	assignment, err := uc.repo.Next(ctx, driverID, now)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "UNPLANNED_DRIVER: no assignment in roster", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if assignment.PlannedStart.After(now.Add(rosterTolerance)) {
+		return fmt.Sprintf("UNPLANNED_DRIVER: next assignment starts at %s", assignment.PlannedStart.Format(time.RFC3339)), nil
+	}
+	if assignment.BusID != busID {
+		return "", fmt.Errorf("%w: planned bus %d", ErrAssignmentMismatch, assignment.BusID)
+	}
+	return "", nil
+}
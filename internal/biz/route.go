@@ -2,32 +2,53 @@ package biz
 
 import (
 	"context"
-	"encoding/json"
+	"sort"
+	"sync"
 	"time"
 
 	mapS "bus-service/api/map/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/rabbitmq/amqp091-go"
 )
 
 type Route struct {
-	Id       uint32
-	Number   string
-	Path     string
-	Time     []float32
+	Id     uint32
+	Number string
+	Path   string
+	// Time время участков между остановками, секунды
+	Time []float32
+	// Lengths длины участков между остановками, метры, как их отдает map-service
 	Lengths  []float32
 	Stations []Stations
-	Length   float32
+	// Length длина маршрута, метры
+	Length float32
+}
+
+const metersPerKm = 1000
+
+// DistanceKm длина маршрута в километрах: сумма участков, а без них Length
+func (r *Route) DistanceKm() float32 {
+	meters := float32(0)
+	for _, length := range r.Lengths {
+		meters += length
+	}
+	if meters == 0 {
+		meters = r.Length
+	}
+	return meters / metersPerKm
 }
 
 type Accident struct {
-	Id        uint64     `json:"id" gorm:"primaryKey"`
+	Id        uint64     `json:"id"`
 	Name      string     `json:"name"`
 	Lat       float64    `json:"lat"`
 	Lon       float64    `json:"lon"`
 	StartDate time.Time  `json:"start_date"`
 	EndDate   *time.Time `json:"end_date,omitempty"`
+	// ExternalID id ДТП во внешней системе, из которой оно пришло через очередь
+	ExternalID *uint64 `json:"external_id,omitempty"`
+	// маршруты, через которые проходит место ДТП
+	RouteIDs []uint32 `json:"route_ids"`
 }
 
 type RouteRepo interface {
@@ -40,25 +61,106 @@ type RouteRepo interface {
 
 type RouteUseCase struct {
 	repo      RouteRepo
+	tx        Transaction
 	mapClient mapS.MapClient
 	logger    *log.Helper
-	rabbit    *RabbitData
+	notifier  *Notifier
+	outbox    *Outbox
 }
 
-func NewRouteUseCase(repo RouteRepo, logger log.Logger, mapClient mapS.MapClient, rabbit *RabbitData) *RouteUseCase {
-	return &RouteUseCase{repo: repo, logger: log.NewHelper(logger), mapClient: mapClient, rabbit: rabbit}
+func NewRouteUseCase(repo RouteRepo, tx Transaction, logger log.Logger, mapClient mapS.MapClient, notifier *Notifier, outbox *Outbox) *RouteUseCase {
+	return &RouteUseCase{repo: repo, tx: tx, logger: log.NewHelper(logger), mapClient: mapClient, notifier: notifier, outbox: outbox}
+}
+
+// Plan строит геометрию маршрута по остановкам через map-service
+func (uc *RouteUseCase) Plan(ctx context.Context, route *Route) error {
+	points := make([]*mapS.Point, 0, len(route.Stations))
+	for _, station := range route.Stations {
+		points = append(points, &mapS.Point{
+			Lat: float32(station.Lat),
+			Lon: float32(station.Lon),
+		})
+	}
+	req, err := uc.mapClient.GetPath(ctx, &mapS.GetPathRequest{
+		Points: points,
+	})
+	if err != nil {
+		return err
+	}
+	route.Path = req.Shape
+	route.Time = req.Time
+	route.Lengths = req.Lengths
+	route.Length = req.Length
+	return nil
 }
 
 func (uc *RouteUseCase) Create(ctx context.Context, route *Route) error {
-	return uc.repo.Create(ctx, route)
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Create(ctx, route); err != nil {
+			return err
+		}
+		return uc.outbox.Event(ctx, routeCreatedEvent(route))
+	})
 }
 
+// Update сохраняет маршрут. Если изменился состав или порядок остановок,
+// геометрия маршрута заново строится через map-service, а в social уходит уведомление.
 func (uc *RouteUseCase) Update(ctx context.Context, route *Route) error {
-	return uc.repo.Update(ctx, route)
+	current, err := uc.repo.GetById(ctx, route.Id)
+	if err != nil {
+		return err
+	}
+	changed := stationsChanged(current.Stations, route.Stations)
+	if changed {
+		if err := uc.Plan(ctx, route); err != nil {
+			return err
+		}
+	} else {
+		route.Path = current.Path
+		route.Time = current.Time
+		route.Lengths = current.Lengths
+		route.Length = current.Length
+	}
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, route); err != nil {
+			return err
+		}
+		if err := uc.outbox.Event(ctx, routeUpdatedEvent(route, changed)); err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+		return uc.notifier.Publish(ctx, &Notification{
+			Type:         NotificationRouteChange,
+			RouteIDs:     []uint32{route.Id},
+			RouteNumbers: []string{route.Number},
+		})
+	})
+}
+
+func stationsChanged(current, next []Stations) bool {
+	if len(current) != len(next) {
+		return true
+	}
+	for i := range current {
+		if next[i].ID != 0 && next[i].ID != current[i].ID {
+			return true
+		}
+		if next[i].Lat != current[i].Lat || next[i].Lon != current[i].Lon {
+			return true
+		}
+	}
+	return false
 }
 
 func (uc *RouteUseCase) Delete(ctx context.Context, id uint32) error {
-	return uc.repo.Delete(ctx, id)
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return uc.outbox.Event(ctx, routeDeletedEvent(id))
+	})
 }
 
 func (uc *RouteUseCase) GetById(ctx context.Context, id uint32) (*Route, error) {
@@ -69,51 +171,67 @@ func (uc *RouteUseCase) List(ctx context.Context) ([]*Route, int64, error) {
 	return uc.repo.List(ctx)
 }
 
-type MessageDTO struct {
-	Message string `json:"message"`
+// accidentWorkers сколько маршрутов одновременно проверяется в map-service
+const accidentWorkers = 8
+
+// AccidentResult итог проверки маршрутов по ДТП
+type AccidentResult struct {
+	// Affected маршруты, через которые проходит место ДТП, по возрастанию id
+	Affected []uint32
+	// Numbers номера затронутых маршрутов по id
+	Numbers map[uint32]string
+	// Failed маршруты, которые не удалось проверить, с причиной
+	Failed  map[uint32]error
+	Checked int
 }
 
-func (uc *RouteUseCase) NewAccident(ctx context.Context, accident *Accident) {
-	routes, _, err := uc.List(context.TODO())
+// CheckAccident проверяет все маршруты на прохождение через место ДТП. Ошибка
+// проверки одного маршрута не прерывает проверку остальных. Запросы в map-service
+// долгие, поэтому вызывается до открытия транзакции.
+func (uc *RouteUseCase) CheckAccident(ctx context.Context, accident *Accident) (*AccidentResult, error) {
+	routes, _, err := uc.List(ctx)
 	if err != nil {
-		return
+		return nil, err
+	}
+	result := &AccidentResult{
+		Affected: make([]uint32, 0),
+		Numbers:  map[uint32]string{},
+		Failed:   map[uint32]error{},
+		Checked:  len(routes),
 	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, accidentWorkers)
 	for _, route := range routes {
-		req, err := uc.mapClient.CheckPath(context.TODO(), &mapS.CheckPathRequest{
-			Shape: route.Path,
-			Point: &mapS.Point{
-				Lat: float32(accident.Lat),
-				Lon: float32(accident.Lon),
-			},
-		})
-		if err != nil {
-			return
-		}
-		jsonData, err := json.Marshal(&MessageDTO{
-			Message: "Обнаружена дтп по маршруту " + route.Number + " извините за ожидание автобуса",
-		})
-		if err != nil {
-			return
-		}
-		if req.IsValid {
-			q, _ := uc.rabbit.Ch.QueueDeclare(
-				"social", // name
-				false,    // durable
-				false,    // delete when unused
-				false,    // exclusive
-				false,    // no-wait
-				nil,      // arguments
-			)
-			uc.rabbit.Ch.PublishWithContext(context.TODO(),
-				"",
-				q.Name,
-				false,
-				false,
-				amqp091.Publishing{
-					ContentType:  "application/json",
-					Body:         jsonData,
-					DeliveryMode: amqp091.Persistent,
-				})
-		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(route *Route) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			req, err := uc.mapClient.CheckPath(ctx, &mapS.CheckPathRequest{
+				Shape: route.Path,
+				Point: &mapS.Point{
+					Lat: float32(accident.Lat),
+					Lon: float32(accident.Lon),
+				},
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				uc.logger.Errorf("accident %d: check route %d: %s", accident.Id, route.Id, err)
+				result.Failed[route.Id] = err
+				return
+			}
+			if req.IsValid {
+				result.Affected = append(result.Affected, route.Id)
+				result.Numbers[route.Id] = route.Number
+			}
+		}(route)
+	}
+	wg.Wait()
+	sort.Slice(result.Affected, func(i, j int) bool { return result.Affected[i] < result.Affected[j] })
+	if len(result.Failed) > 0 {
+		uc.logger.Warnf("accident %d: %d of %d routes failed", accident.Id, len(result.Failed), result.Checked)
 	}
+	return result, nil
 }
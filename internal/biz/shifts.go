@@ -5,28 +5,49 @@ import (
 	"errors"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 )
 
 type Shift struct {
-	Id        uint32
-	StartTime time.Time
-	EndDate   *time.Time
-	DriverID  string
+	Id        uint32     `json:"id"`
+	StartTime time.Time  `json:"start_time"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+	DriverID  string     `json:"driver_id"`
+	// заряд автобуса на начало смены
+	StartBattery *uint `json:"start_battery,omitempty"`
+	// автобус и маршрут, на которых отработана смена
+	BusID       *uint32 `json:"bus_id,omitempty"`
+	BusNumber   *string `json:"bus_number,omitempty"`
+	RouteID     *uint32 `json:"route_id,omitempty"`
+	RouteNumber *string `json:"route_number,omitempty"`
+	// смена превысила допустимую длительность
+	Overrun bool `json:"overrun"`
 }
 
 type ShiftRepo interface {
 	Create(context.Context, *Shift) error
 	Update(context.Context, *Shift) error
 	GetByDriverID(context.Context, string) (*Shift, error)
+	// List смены водителя, пересекающиеся с периодом [from, to)
+	List(ctx context.Context, driverID string, from, to *time.Time) ([]*Shift, error)
+	// ListOverrun открытые непомеченные смены, начатые до startedBefore
+	ListOverrun(ctx context.Context, startedBefore time.Time) ([]*Shift, error)
+	MarkOverrun(context.Context, uint32) error
 }
 
 type ShiftUseCase struct {
-	repo ShiftRepo
+	repo    ShiftRepo
+	buses   *BusUseCase
+	battery *BatteryUseCase
+	tx      Transaction
+	rules   *Regulation
+	outbox  *Outbox
+	logger  *log.Helper
 }
 
-func NewShiftUseCase(repo ShiftRepo) *ShiftUseCase {
-	return &ShiftUseCase{repo: repo}
+func NewShiftUseCase(repo ShiftRepo, buses *BusUseCase, battery *BatteryUseCase, tx Transaction, rules *Regulation, outbox *Outbox, logger log.Logger) *ShiftUseCase {
+	return &ShiftUseCase{repo: repo, buses: buses, battery: battery, tx: tx, rules: rules, outbox: outbox, logger: log.NewHelper(logger)}
 }
 
 func (uc *ShiftUseCase) Create(ctx context.Context, shift *Shift) error {
@@ -35,7 +56,7 @@ func (uc *ShiftUseCase) Create(ctx context.Context, shift *Shift) error {
 			return err
 		}
 		if data != nil {
-			return errors.New("DRIVER_IN_DRIVE")
+			return ErrDriverInDrive
 		}
 	}
 	return uc.repo.Create(ctx, shift)
@@ -49,6 +70,145 @@ func (uc *ShiftUseCase) GetByDriverID(ctx context.Context, driverId string) (*Sh
 	return uc.repo.GetByDriverID(ctx, driverId)
 }
 
+// Start открывает смену водителя и переводит автобус в работу одной транзакцией
+func (uc *ShiftUseCase) Start(ctx context.Context, busID uint32, driverID string) (*Bus, error) {
+	var bus *Bus
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		bus, err = uc.buses.GetByIdForUpdate(ctx, busID)
+		if err != nil {
+			return err
+		}
+		if bus.DriverID != nil {
+			return ErrBusInUse
+		}
+		if !bus.Status.CanTransition(BusStatusInService) {
+			return ErrInvalidStatusTransition
+		}
+		now := time.Now()
+		if err := uc.checkRegulation(ctx, driverID, now); err != nil {
+			return err
+		}
+		shift := &Shift{
+			StartTime:    now,
+			DriverID:     driverID,
+			StartBattery: &bus.BatteryLevel,
+			BusID:        &bus.Id,
+			RouteID:      bus.RouteID,
+		}
+		if err := uc.Create(ctx, shift); err != nil {
+			return err
+		}
+		if err := uc.outbox.Event(ctx, shiftStartedEvent(shift)); err != nil {
+			return err
+		}
+		return uc.buses.ChangeStatus(ctx, bus, BusStatusInService, &driverID, driverID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bus, nil
+}
+
+// Stop закрывает смену водителя и освобождает автобус. Завершить смену
+// может только водитель, закрепленный за автобусом
+func (uc *ShiftUseCase) Stop(ctx context.Context, busID uint32, driverID string) error {
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		bus, err := uc.buses.GetByIdForUpdate(ctx, busID)
+		if err != nil {
+			return err
+		}
+		if bus.DriverID == nil {
+			return ErrBusNoDriver
+		}
+		if *bus.DriverID != driverID {
+			return ErrBusHasDriver
+		}
+		return uc.finish(ctx, bus, driverID, BusStatusIdle, driverID)
+	})
+}
+
+// Charge ставит автобус на зарядку. Если автобус в работе, зарядить его может
+// только свой водитель, и его смена при этом закрывается.
+func (uc *ShiftUseCase) Charge(ctx context.Context, busID uint32, userID string) error {
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		bus, err := uc.buses.GetByIdForUpdate(ctx, busID)
+		if err != nil {
+			return err
+		}
+		if bus.DriverID == nil {
+			return uc.buses.ChangeStatus(ctx, bus, BusStatusCharging, nil, userID)
+		}
+		if *bus.DriverID != userID {
+			return ErrBusHasDriver
+		}
+		return uc.finish(ctx, bus, userID, BusStatusCharging, userID)
+	})
+}
+
+// Release принудительно освобождает автобус диспетчером: закрывает открытую смену
+// водителя, автобус в работе переводится в idle, остальные статусы сохраняются
+func (uc *ShiftUseCase) Release(ctx context.Context, busID uint32, changedBy string) error {
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		bus, err := uc.buses.GetByIdForUpdate(ctx, busID)
+		if err != nil {
+			return err
+		}
+		if bus.DriverID == nil {
+			return nil
+		}
+		if bus.Status == BusStatusInService {
+			return uc.finish(ctx, bus, *bus.DriverID, BusStatusIdle, changedBy)
+		}
+		if err := uc.closeShift(ctx, bus, *bus.DriverID); err != nil {
+			return err
+		}
+		return uc.buses.Detach(ctx, bus, changedBy)
+	})
+}
+
+func (uc *ShiftUseCase) finish(ctx context.Context, bus *Bus, driverID string, to BusStatus, changedBy string) error {
+	if !bus.Status.CanTransition(to) {
+		return ErrInvalidStatusTransition
+	}
+	shift, err := uc.repo.GetByDriverID(ctx, driverID)
+	if err != nil {
+		return err
+	}
+	// открытая смена водителя должна относиться к этому автобусу
+	if shift.BusID != nil && *shift.BusID != bus.Id {
+		return ErrShiftBusMismatch
+	}
+	if err := uc.end(ctx, shift, bus); err != nil {
+		return err
+	}
+	return uc.buses.ChangeStatus(ctx, bus, to, nil, changedBy)
+}
+
+// closeShift закрывает смену водителя, если она открыта
+func (uc *ShiftUseCase) closeShift(ctx context.Context, bus *Bus, driverID string) error {
+	shift, err := uc.repo.GetByDriverID(ctx, driverID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return uc.end(ctx, shift, bus)
+}
+
+func (uc *ShiftUseCase) end(ctx context.Context, shift *Shift, bus *Bus) error {
+	endTime := time.Now()
+	shift.EndDate = &endTime
+	if err := uc.repo.Update(ctx, shift); err != nil {
+		return err
+	}
+	if err := uc.battery.RecordShift(ctx, shift, bus); err != nil {
+		return err
+	}
+	return uc.outbox.Event(ctx, shiftEndedEvent(shift))
+}
+
 func (uc *ShiftUseCase) GetHours(ctx context.Context, driverId string) (float64, error) {
 	shift, err := uc.repo.GetByDriverID(ctx, driverId)
 	if err != nil {
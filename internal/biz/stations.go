@@ -3,10 +3,12 @@ package biz
 import "context"
 
 type Stations struct {
-	ID     uint
-	Name   string
-	Lat    float64
-	Lon    float64
+	ID   uint
+	Name string
+	Lat  float64
+	Lon  float64
+	// GtfsID stop_id остановки в импортированном фиде GTFS
+	GtfsID *string
 	Routes []Route
 }
 
@@ -15,13 +17,42 @@ type StationsPatch struct {
 	Name   *string
 	Lat    *float64
 	Lon    *float64
+	GtfsID *string
 	Routes *[]*Route
 }
 
 type StationRepo interface {
-	// Create(context.Context, *Stations) error
+	Create(context.Context, *Stations) error
 	Update(context.Context, *StationsPatch) error
 	GetById(context.Context, uint32) (Stations, error)
 	List(context.Context) ([]*Stations, int64, error)
 	Delete(context.Context, uint32) error
 }
+
+type StationUseCase struct {
+	repo StationRepo
+}
+
+func NewStationUseCase(repo StationRepo) *StationUseCase {
+	return &StationUseCase{repo: repo}
+}
+
+func (uc *StationUseCase) Create(ctx context.Context, station *Stations) error {
+	return uc.repo.Create(ctx, station)
+}
+
+func (uc *StationUseCase) Update(ctx context.Context, patch *StationsPatch) error {
+	return uc.repo.Update(ctx, patch)
+}
+
+func (uc *StationUseCase) GetById(ctx context.Context, id uint32) (Stations, error) {
+	return uc.repo.GetById(ctx, id)
+}
+
+func (uc *StationUseCase) List(ctx context.Context) ([]*Stations, int64, error) {
+	return uc.repo.List(ctx)
+}
+
+func (uc *StationUseCase) Delete(ctx context.Context, id uint32) error {
+	return uc.repo.Delete(ctx, id)
+}
@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type BusStatus string
+
+const (
+	BusStatusIdle        BusStatus = "idle"
+	BusStatusInService   BusStatus = "in_service"
+	BusStatusCharging    BusStatus = "charging"
+	BusStatusMaintenance BusStatus = "maintenance"
+	BusStatusOutOfOrder  BusStatus = "out_of_order"
+)
+
+var (
+	ErrInvalidStatusTransition = errors.New("INVALID_STATUS_TRANSITION")
+	ErrUnknownStatus           = errors.New("UNKNOWN_STATUS")
+	ErrBusHasDriver            = errors.New("BUS_HAS_DRIVER")
+	ErrBusInUse                = errors.New("BUS_IN_USE")
+	ErrBusNoDriver             = errors.New("BUS_HAS_NO_DRIVER")
+	ErrShiftBusMismatch        = errors.New("SHIFT_BUS_MISMATCH")
+	ErrDriverInDrive           = errors.New("DRIVER_IN_DRIVE")
+)
+
+// busTransitions допустимые переходы статусов. Основной цикл idle -> in_service -> charging -> idle,
+// в обслуживание автобус уходит только из idle, charging или out_of_order
+var busTransitions = map[BusStatus][]BusStatus{
+	BusStatusIdle:        {BusStatusInService, BusStatusCharging, BusStatusMaintenance, BusStatusOutOfOrder},
+	BusStatusInService:   {BusStatusIdle, BusStatusCharging, BusStatusOutOfOrder},
+	BusStatusCharging:    {BusStatusIdle, BusStatusMaintenance, BusStatusOutOfOrder},
+	BusStatusMaintenance: {BusStatusIdle, BusStatusOutOfOrder},
+	BusStatusOutOfOrder:  {BusStatusIdle, BusStatusMaintenance},
+}
+
+func ParseBusStatus(value string) (BusStatus, error) {
+	status := BusStatus(value)
+	if _, ok := busTransitions[status]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownStatus, value)
+	}
+	return status, nil
+}
+
+func (s BusStatus) CanTransition(to BusStatus) bool {
+	for _, allowed := range busTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// BusStatusChange запись истории статусов автобуса
+type BusStatusChange struct {
+	Id    uint64    `json:"id"`
+	BusID uint32    `json:"bus_id"`
+	From  BusStatus `json:"from"`
+	To    BusStatus `json:"to"`
+	// ChangedBy пользователь Keycloak, изменивший статус
+	ChangedBy string    `json:"changed_by"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type BusStatusRepo interface {
+	Create(context.Context, *BusStatusChange) error
+	List(context.Context, uint32) ([]*BusStatusChange, error)
+}
@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	FleetEventBusStatus = "bus.status"
+	FleetEventPosition  = "bus.position"
+	FleetEventAccident  = "accident"
+)
+
+// FleetEvent событие для диспетчерских панелей
+type FleetEvent struct {
+	Type string `json:"type"`
+	// RouteIDs маршруты, к которым относится событие
+	RouteIDs []uint32    `json:"route_ids,omitempty"`
+	Data     interface{} `json:"data"`
+	Time     time.Time   `json:"time"`
+}
+
+type FleetSubscription struct {
+	C      <-chan FleetEvent
+	ch     chan FleetEvent
+	routes map[uint32]bool
+}
+
+func (s *FleetSubscription) match(event FleetEvent) bool {
+	if len(s.routes) == 0 {
+		return true
+	}
+	for _, id := range event.RouteIDs {
+		if s.routes[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// FleetStream рассылает события автопарка подписчикам в пределах процесса
+type FleetStream struct {
+	mu   sync.RWMutex
+	subs map[*FleetSubscription]struct{}
+}
+
+func NewFleetStream() *FleetStream {
+	return &FleetStream{subs: map[*FleetSubscription]struct{}{}}
+}
+
+// Subscribe подписывает на события указанных маршрутов, пустой список - на все события
+func (s *FleetStream) Subscribe(routeIDs []uint32) *FleetSubscription {
+	ch := make(chan FleetEvent, 64)
+	sub := &FleetSubscription{C: ch, ch: ch, routes: map[uint32]bool{}}
+	for _, id := range routeIDs {
+		sub.routes[id] = true
+	}
+	s.mu.Lock()
+	s.subs[sub] = struct{}{}
+	s.mu.Unlock()
+	return sub
+}
+
+func (s *FleetStream) Unsubscribe(sub *FleetSubscription) {
+	s.mu.Lock()
+	if _, ok := s.subs[sub]; ok {
+		delete(s.subs, sub)
+		close(sub.ch)
+	}
+	s.mu.Unlock()
+}
+
+// Publish не блокируется: медленный подписчик пропускает события
+func (s *FleetStream) Publish(event FleetEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for sub := range s.subs {
+		if !sub.match(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+		}
+	}
+}
+
+func routeIDs(routeID *uint32) []uint32 {
+	if routeID == nil {
+		return nil
+	}
+	return []uint32{*routeID}
+}
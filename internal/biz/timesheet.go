@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// WorkedHours отработанные часы за период. Period имеет вид
+// 2006-01-02 для дня, 2006-W01 для недели ISO и 2006-01 для месяца
+type WorkedHours struct {
+	Period string  `json:"period"`
+	Hours  float64 `json:"hours"`
+}
+
+type Timesheet struct {
+	Shifts     []*Shift       `json:"shifts"`
+	TotalHours float64        `json:"total_hours"`
+	Days       []*WorkedHours `json:"days"`
+	Weeks      []*WorkedHours `json:"weeks"`
+	Months     []*WorkedHours `json:"months"`
+}
+
+// Timesheet табель водителя за период. Смены, выходящие за границы периода
+// или суток, учитываются только своей частью внутри них.
+func (uc *ShiftUseCase) Timesheet(ctx context.Context, driverID string, from, to *time.Time) (*Timesheet, error) {
+	shifts, err := uc.repo.List(ctx, driverID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	days := map[string]float64{}
+	weeks := map[string]float64{}
+	months := map[string]float64{}
+	sheet := &Timesheet{Shifts: shifts}
+	for _, shift := range shifts {
+		start := shift.StartTime.Local()
+		end := now
+		if shift.EndDate != nil {
+			end = shift.EndDate.Local()
+		}
+		// границы периода приходят в зоне запроса, а сутки считаются в локальной
+		if from != nil && start.Before(*from) {
+			start = from.Local()
+		}
+		if to != nil && end.After(*to) {
+			end = to.Local()
+		}
+		for start.Before(end) {
+			y, m, d := start.Date()
+			next := time.Date(y, m, d+1, 0, 0, 0, 0, start.Location())
+			if next.After(end) {
+				next = end
+			}
+			hours := next.Sub(start).Hours()
+			year, week := start.ISOWeek()
+			days[start.Format("2006-01-02")] += hours
+			weeks[fmt.Sprintf("%d-W%02d", year, week)] += hours
+			months[start.Format("2006-01")] += hours
+			sheet.TotalHours += hours
+			start = next
+		}
+	}
+	sheet.Days = sortHours(days)
+	sheet.Weeks = sortHours(weeks)
+	sheet.Months = sortHours(months)
+	return sheet, nil
+}
+
+func sortHours(hours map[string]float64) []*WorkedHours {
+	result := make([]*WorkedHours, 0, len(hours))
+	for period, value := range hours {
+		result = append(result, &WorkedHours{Period: period, Hours: value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Period < result[j].Period
+	})
+	return result
+}
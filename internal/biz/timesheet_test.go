@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+// shiftRepoStub отдает смены из памяти с той же фильтрацией периода, что и хранилище
+type shiftRepoStub struct {
+	ShiftRepo
+	shifts []*Shift
+}
+
+func (r *shiftRepoStub) List(_ context.Context, driverID string, from, to *time.Time) ([]*Shift, error) {
+	result := make([]*Shift, 0)
+	for _, shift := range r.shifts {
+		if shift.DriverID != driverID {
+			continue
+		}
+		if from != nil && shift.EndDate != nil && !shift.EndDate.After(*from) {
+			continue
+		}
+		if to != nil && !shift.StartTime.Before(*to) {
+			continue
+		}
+		result = append(result, shift)
+	}
+	return result, nil
+}
+
+// useLocal подменяет локальную зону на время теста, чтобы границы суток не зависели от машины
+func useLocal(t *testing.T, zone *time.Location) {
+	local := time.Local
+	time.Local = zone
+	t.Cleanup(func() { time.Local = local })
+}
+
+func closedShift(start, end time.Time) *Shift {
+	return &Shift{DriverID: "driver", StartTime: start, EndDate: &end}
+}
+
+func hoursByPeriod(hours []*WorkedHours) map[string]float64 {
+	result := make(map[string]float64, len(hours))
+	for _, h := range hours {
+		result[h.Period] = h.Hours
+	}
+	return result
+}
+
+func assertHours(t *testing.T, kind string, got []*WorkedHours, want map[string]float64) {
+	t.Helper()
+	periods := hoursByPeriod(got)
+	if len(periods) != len(want) {
+		t.Errorf("%s = %v, want %v", kind, periods, want)
+		return
+	}
+	for period, hours := range want {
+		if math.Abs(periods[period]-hours) > 1e-9 {
+			t.Errorf("%s[%s] = %v, want %v", kind, period, periods[period], hours)
+		}
+	}
+}
+
+func TestTimesheet(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	useLocal(t, msk)
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2024, month, day, hour, 0, 0, 0, msk)
+	}
+	utc := func(v time.Time) *time.Time {
+		v = v.UTC()
+		return &v
+	}
+	tests := []struct {
+		name     string
+		shifts   []*Shift
+		from, to *time.Time
+		total    float64
+		days     map[string]float64
+		weeks    map[string]float64
+		months   map[string]float64
+	}{
+		{
+			name:   "across midnight and week boundary",
+			shifts: []*Shift{closedShift(at(1, 7, 22), at(1, 8, 6))},
+			total:  8,
+			days:   map[string]float64{"2024-01-07": 2, "2024-01-08": 6},
+			weeks:  map[string]float64{"2024-W01": 2, "2024-W02": 6},
+			months: map[string]float64{"2024-01": 8},
+		},
+		{
+			name:   "across month boundary",
+			shifts: []*Shift{closedShift(at(1, 31, 20), at(2, 1, 4))},
+			total:  8,
+			days:   map[string]float64{"2024-01-31": 4, "2024-02-01": 4},
+			weeks:  map[string]float64{"2024-W05": 8},
+			months: map[string]float64{"2024-01": 4, "2024-02": 4},
+		},
+		{
+			name:   "clamped to period given in another zone",
+			shifts: []*Shift{closedShift(at(1, 7, 22), at(1, 8, 6))},
+			from:   utc(at(1, 8, 0)),
+			to:     utc(at(1, 8, 3)),
+			total:  3,
+			days:   map[string]float64{"2024-01-08": 3},
+			weeks:  map[string]float64{"2024-W02": 3},
+			months: map[string]float64{"2024-01": 3},
+		},
+		{
+			name: "several shifts on one day",
+			shifts: []*Shift{
+				closedShift(at(1, 10, 6), at(1, 10, 10)),
+				closedShift(at(1, 10, 12), at(1, 10, 15)),
+			},
+			total:  7,
+			days:   map[string]float64{"2024-01-10": 7},
+			weeks:  map[string]float64{"2024-W02": 7},
+			months: map[string]float64{"2024-01": 7},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &ShiftUseCase{repo: &shiftRepoStub{shifts: tt.shifts}}
+			sheet, err := uc.Timesheet(context.Background(), "driver", tt.from, tt.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(sheet.TotalHours-tt.total) > 1e-9 {
+				t.Errorf("total = %v, want %v", sheet.TotalHours, tt.total)
+			}
+			assertHours(t, "days", sheet.Days, tt.days)
+			assertHours(t, "weeks", sheet.Weeks, tt.weeks)
+			assertHours(t, "months", sheet.Months, tt.months)
+		})
+	}
+}
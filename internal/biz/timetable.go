@@ -0,0 +1,311 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+var ErrInvalidTimetable = errors.New("INVALID_TIMETABLE")
+
+// ClockTime время от полуночи служебных суток в секундах. Может быть больше
+// 24 часов для рейсов после полуночи, в JSON передается как HH:MM:SS
+type ClockTime int32
+
+func ParseClockTime(value string) (ClockTime, error) {
+	var h, m, s int
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("%w: time %q", ErrInvalidTimetable, value)
+	}
+	if _, err := fmt.Sscanf(parts[0]+" "+parts[1], "%d %d", &h, &m); err != nil {
+		return 0, fmt.Errorf("%w: time %q", ErrInvalidTimetable, value)
+	}
+	if len(parts) == 3 {
+		if _, err := fmt.Sscanf(parts[2], "%d", &s); err != nil {
+			return 0, fmt.Errorf("%w: time %q", ErrInvalidTimetable, value)
+		}
+	}
+	if h < 0 || m < 0 || m > 59 || s < 0 || s > 59 {
+		return 0, fmt.Errorf("%w: time %q", ErrInvalidTimetable, value)
+	}
+	return ClockTime(h*3600 + m*60 + s), nil
+}
+
+func (t ClockTime) String() string {
+	return fmt.Sprintf("%02d:%02d:%02d", t/3600, t%3600/60, t%60)
+}
+
+func (t ClockTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *ClockTime) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseClockTime(value)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// On момент времени для служебных суток, начинающихся в полночь date
+func (t ClockTime) On(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, date.Location()).Add(time.Duration(t) * time.Second)
+}
+
+// Timetable расписание маршрута. Рейсы задаются либо явным списком Trips,
+// либо интервалом Headway между FirstDeparture и LastDeparture
+type Timetable struct {
+	Id      uint32 `json:"id"`
+	RouteID uint32 `json:"route_id"`
+	Name    string `json:"name"`
+	// дни обслуживания по ISO: 1 понедельник ... 7 воскресенье
+	ServiceDays    []int       `json:"service_days"`
+	FirstDeparture *ClockTime  `json:"first_departure,omitempty"`
+	LastDeparture  *ClockTime  `json:"last_departure,omitempty"`
+	Headway        uint32      `json:"headway,omitempty"` // секунды
+	Trips          []ClockTime `json:"trips,omitempty"`
+	// стоянка на каждой промежуточной остановке, секунды
+	Dwell uint32 `json:"dwell"`
+}
+
+// Trip рейс с плановым временем на каждой остановке маршрута
+type Trip struct {
+	Departure ClockTime   `json:"departure"`
+	StopTimes []ClockTime `json:"stop_times"`
+}
+
+// Departure отправление с остановки
+type Departure struct {
+	RouteID     uint32    `json:"route_id"`
+	RouteNumber string    `json:"route_number"`
+	TimetableID uint32    `json:"timetable_id"`
+	Time        ClockTime `json:"time"`
+	At          time.Time `json:"at"`
+}
+
+type TimetableRepo interface {
+	Create(context.Context, *Timetable) error
+	Update(context.Context, *Timetable) error
+	Delete(context.Context, uint32) error
+	GetById(context.Context, uint32) (*Timetable, error)
+	// List расписания маршрутов routeIDs, пустой список возвращает все
+	List(ctx context.Context, routeIDs []uint32) ([]*Timetable, error)
+}
+
+func (t *Timetable) validate() error {
+	if len(t.ServiceDays) == 0 {
+		return fmt.Errorf("%w: no service days", ErrInvalidTimetable)
+	}
+	for _, day := range t.ServiceDays {
+		if day < 1 || day > 7 {
+			return fmt.Errorf("%w: service day %d", ErrInvalidTimetable, day)
+		}
+	}
+	if len(t.Trips) > 0 {
+		return nil
+	}
+	if t.FirstDeparture == nil || t.LastDeparture == nil || t.Headway == 0 {
+		return fmt.Errorf("%w: trips or first/last departure with headway required", ErrInvalidTimetable)
+	}
+	if *t.LastDeparture < *t.FirstDeparture {
+		return fmt.Errorf("%w: last departure before first", ErrInvalidTimetable)
+	}
+	return nil
+}
+
+// RunsOn проверяет, обслуживается ли расписание в день date
+func (t *Timetable) RunsOn(date time.Time) bool {
+	weekday := (int(date.Weekday())+6)%7 + 1
+	for _, day := range t.ServiceDays {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// Departures время отправления рейсов с первой остановки
+func (t *Timetable) Departures() []ClockTime {
+	if len(t.Trips) > 0 {
+		departures := append([]ClockTime(nil), t.Trips...)
+		sort.Slice(departures, func(i, j int) bool { return departures[i] < departures[j] })
+		return departures
+	}
+	departures := make([]ClockTime, 0)
+	if t.FirstDeparture == nil || t.LastDeparture == nil || t.Headway == 0 {
+		return departures
+	}
+	for d := *t.FirstDeparture; d <= *t.LastDeparture; d += ClockTime(t.Headway) {
+		departures = append(departures, d)
+	}
+	return departures
+}
+
+// StopOffsets смещение прибытия на каждую остановку от отправления рейса.
+// Route.Time содержит время в пути между соседними остановками в секундах,
+// отсутствующие участки считаются нулевыми.
+func StopOffsets(route *Route, dwell uint32) []ClockTime {
+	offsets := make([]ClockTime, len(route.Stations))
+	var offset float32
+	for i := 1; i < len(route.Stations); i++ {
+		if i-1 < len(route.Time) {
+			offset += route.Time[i-1]
+		}
+		offsets[i] = ClockTime(offset+0.5) + ClockTime(dwell)*ClockTime(i-1)
+	}
+	return offsets
+}
+
+// BuildTrips рейсы расписания с временем на каждой остановке маршрута
+func BuildTrips(timetable *Timetable, route *Route) []*Trip {
+	offsets := StopOffsets(route, timetable.Dwell)
+	trips := make([]*Trip, 0)
+	for _, departure := range timetable.Departures() {
+		stopTimes := make([]ClockTime, len(offsets))
+		for i, offset := range offsets {
+			stopTimes[i] = departure + offset
+		}
+		trips = append(trips, &Trip{Departure: departure, StopTimes: stopTimes})
+	}
+	return trips
+}
+
+type TimetableUseCase struct {
+	repo     TimetableRepo
+	routes   RouteRepo
+	stations StationRepo
+	agency   *GtfsAgency
+}
+
+func NewTimetableUseCase(repo TimetableRepo, routes RouteRepo, stations StationRepo, agency *GtfsAgency) *TimetableUseCase {
+	return &TimetableUseCase{repo: repo, routes: routes, stations: stations, agency: agency}
+}
+
+func (uc *TimetableUseCase) Create(ctx context.Context, timetable *Timetable) error {
+	if err := timetable.validate(); err != nil {
+		return err
+	}
+	if _, err := uc.routes.GetById(ctx, timetable.RouteID); err != nil {
+		return err
+	}
+	return uc.repo.Create(ctx, timetable)
+}
+
+func (uc *TimetableUseCase) Update(ctx context.Context, timetable *Timetable) error {
+	if err := timetable.validate(); err != nil {
+		return err
+	}
+	if _, err := uc.repo.GetById(ctx, timetable.Id); err != nil {
+		return err
+	}
+	if _, err := uc.routes.GetById(ctx, timetable.RouteID); err != nil {
+		return err
+	}
+	return uc.repo.Update(ctx, timetable)
+}
+
+func (uc *TimetableUseCase) Delete(ctx context.Context, id uint32) error {
+	return uc.repo.Delete(ctx, id)
+}
+
+func (uc *TimetableUseCase) GetById(ctx context.Context, id uint32) (*Timetable, error) {
+	return uc.repo.GetById(ctx, id)
+}
+
+func (uc *TimetableUseCase) List(ctx context.Context, routeIDs []uint32) ([]*Timetable, error) {
+	return uc.repo.List(ctx, routeIDs)
+}
+
+// Trips рейсы расписания с временем на остановках
+func (uc *TimetableUseCase) Trips(ctx context.Context, id uint32) ([]*Trip, error) {
+	timetable, err := uc.repo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	route, err := uc.routes.GetById(ctx, timetable.RouteID)
+	if err != nil {
+		return nil, err
+	}
+	return BuildTrips(timetable, route), nil
+}
+
+// Departures ближайшие отправления с остановки начиная с момента at в пределах служебных суток
+func (uc *TimetableUseCase) Departures(ctx context.Context, stationID uint32, at time.Time, limit int) ([]*Departure, error) {
+	// день недели и время расписания считаются в зоне перевозчика
+	at = at.In(uc.agency.Location)
+	station, err := uc.stations.GetById(ctx, stationID)
+	if err != nil {
+		return nil, err
+	}
+	routes := map[uint32]*Route{}
+	routeIDs := make([]uint32, 0, len(station.Routes))
+	for _, r := range station.Routes {
+		route, err := uc.routes.GetById(ctx, r.Id)
+		if err != nil {
+			return nil, err
+		}
+		routes[route.Id] = route
+		routeIDs = append(routeIDs, route.Id)
+	}
+	departures := make([]*Departure, 0)
+	if len(routeIDs) == 0 {
+		return departures, nil
+	}
+	timetables, err := uc.repo.List(ctx, routeIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, timetable := range timetables {
+		if !timetable.RunsOn(at) {
+			continue
+		}
+		route := routes[timetable.RouteID]
+		index := -1
+		for i, s := range route.Stations {
+			if uint32(s.ID) == stationID {
+				index = i
+				break
+			}
+		}
+		// с конечной остановки рейс не отправляется
+		if index < 0 || index == len(route.Stations)-1 {
+			continue
+		}
+		for _, trip := range BuildTrips(timetable, route) {
+			// на промежуточной остановке автобус уходит после стоянки, как в stop_times GTFS
+			stopTime := trip.StopTimes[index]
+			if index > 0 {
+				stopTime += ClockTime(timetable.Dwell)
+			}
+			when := stopTime.On(at)
+			if when.Before(at) {
+				continue
+			}
+			departures = append(departures, &Departure{
+				RouteID:     route.Id,
+				RouteNumber: route.Number,
+				TimetableID: timetable.Id,
+				Time:        stopTime,
+				At:          when,
+			})
+		}
+	}
+	sort.SliceStable(departures, func(i, j int) bool {
+		return departures[i].At.Before(departures[j].At)
+	})
+	if limit > 0 && len(departures) > limit {
+		departures = departures[:limit]
+	}
+	return departures, nil
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseClockTime(t *testing.T) {
+	tests := []struct {
+		value string
+		want  ClockTime
+		err   bool
+	}{
+		{"06:30", 6*3600 + 30*60, false},
+		{"23:59:59", 23*3600 + 59*60 + 59, false},
+		{"24:00", 24 * 3600, false},
+		{"25:10:05", 25*3600 + 10*60 + 5, false},
+		{"06:60", 0, true},
+		{"06:30:60", 0, true},
+		{"-1:00", 0, true},
+		{"6", 0, true},
+		{"aa:bb", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseClockTime(tt.value)
+			if tt.err {
+				if !errors.Is(err, ErrInvalidTimetable) {
+					t.Errorf("error = %v, want %v", err, ErrInvalidTimetable)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClockTimeString(t *testing.T) {
+	tests := []struct {
+		value ClockTime
+		want  string
+	}{
+		{0, "00:00:00"},
+		{6*3600 + 5*60 + 7, "06:05:07"},
+		{24 * 3600, "24:00:00"},
+		{26*3600 + 15*60, "26:15:00"},
+	}
+	for _, tt := range tests {
+		if got := tt.value.String(); got != tt.want {
+			t.Errorf("ClockTime(%d).String() = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestClockTimeOn(t *testing.T) {
+	zone := time.FixedZone("MSK", 3*3600)
+	tests := []struct {
+		name  string
+		value ClockTime
+		date  time.Time
+		want  time.Time
+	}{
+		{"same day", 8 * 3600, time.Date(2024, 1, 10, 15, 0, 0, 0, zone), time.Date(2024, 1, 10, 8, 0, 0, 0, zone)},
+		{"past midnight", 25*3600 + 30*60, time.Date(2024, 1, 10, 0, 0, 0, 0, zone), time.Date(2024, 1, 11, 1, 30, 0, 0, zone)},
+		{"past month end", 24 * 3600, time.Date(2024, 1, 31, 12, 0, 0, 0, zone), time.Date(2024, 2, 1, 0, 0, 0, 0, zone)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.On(tt.date); !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClockTimeJSON(t *testing.T) {
+	value := ClockTime(25*3600 + 5)
+	data, err := value.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"25:00:05"` {
+		t.Errorf("MarshalJSON = %s", data)
+	}
+	var parsed ClockTime
+	if err := parsed.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if parsed != value {
+		t.Errorf("UnmarshalJSON = %d, want %d", parsed, value)
+	}
+}
@@ -0,0 +1,145 @@
+package data
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type Accident struct {
+	Id         uint64  `gorm:"primaryKey"`
+	ExternalID *uint64 `gorm:"uniqueIndex"`
+	Name       string
+	Lat        float64
+	Lon        float64
+	StartDate  time.Time  `gorm:"index"`
+	EndDate    *time.Time `gorm:"index"`
+	Routes     []AccidentRoute
+}
+
+// AccidentRoute маршрут, затронутый ДТП
+type AccidentRoute struct {
+	AccidentID uint64 `gorm:"primaryKey"`
+	RouteID    uint32 `gorm:"primaryKey"`
+}
+
+func (m Accident) modelToResponse() *biz.Accident {
+	routeIDs := make([]uint32, 0, len(m.Routes))
+	for _, route := range m.Routes {
+		routeIDs = append(routeIDs, route.RouteID)
+	}
+	return &biz.Accident{
+		Id:         m.Id,
+		Name:       m.Name,
+		Lat:        m.Lat,
+		Lon:        m.Lon,
+		StartDate:  m.StartDate,
+		EndDate:    m.EndDate,
+		ExternalID: m.ExternalID,
+		RouteIDs:   routeIDs,
+	}
+}
+
+type accidentRepo struct {
+	data *Data
+}
+
+func NewAccidentRepo(data *Data) biz.AccidentRepo {
+	return &accidentRepo{data: data}
+}
+
+// Create implements biz.AccidentRepo.
+func (r *accidentRepo) Create(ctx context.Context, accident *biz.Accident) error {
+	accidentDB := Accident{
+		Id:         accident.Id,
+		ExternalID: accident.ExternalID,
+		Name:       accident.Name,
+		Lat:        accident.Lat,
+		Lon:        accident.Lon,
+		StartDate:  accident.StartDate,
+		EndDate:    accident.EndDate,
+	}
+	if err := r.data.DB(ctx).Create(&accidentDB).Error; err != nil {
+		return err
+	}
+	accident.Id = accidentDB.Id
+	return nil
+}
+
+// Update implements biz.AccidentRepo.
+func (r *accidentRepo) Update(ctx context.Context, accident *biz.Accident) error {
+	accidentDB := Accident{
+		Id:        accident.Id,
+		Name:      accident.Name,
+		Lat:       accident.Lat,
+		Lon:       accident.Lon,
+		StartDate: accident.StartDate,
+		EndDate:   accident.EndDate,
+	}
+	return r.data.DB(ctx).Model(&accidentDB).Select("Name", "Lat", "Lon", "StartDate", "EndDate").Updates(&accidentDB).Error
+}
+
+// GetById implements biz.AccidentRepo.
+func (r *accidentRepo) GetById(ctx context.Context, id uint64) (*biz.Accident, error) {
+	var accidentDB Accident
+	if err := r.data.DB(ctx).Preload("Routes").Where(&Accident{Id: id}).First(&accidentDB).Error; err != nil {
+		return nil, err
+	}
+	return accidentDB.modelToResponse(), nil
+}
+
+// GetByIdForUpdate implements biz.AccidentRepo.
+func (r *accidentRepo) GetByIdForUpdate(ctx context.Context, id uint64) (*biz.Accident, error) {
+	// SELECT ... FOR UPDATE держит блокировку только внутри ExecTx
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where(&Accident{Id: id}).First(&Accident{}).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetById(ctx, id)
+}
+
+// GetByExternalID implements biz.AccidentRepo.
+func (r *accidentRepo) GetByExternalID(ctx context.Context, id uint64) (*biz.Accident, error) {
+	var accidentDB Accident
+	if err := r.data.DB(ctx).Preload("Routes").Where(&Accident{ExternalID: &id}).First(&accidentDB).Error; err != nil {
+		return nil, err
+	}
+	return accidentDB.modelToResponse(), nil
+}
+
+// List implements biz.AccidentRepo.
+func (r *accidentRepo) List(ctx context.Context, active bool) ([]*biz.Accident, error) {
+	var accidentsDB []Accident
+	localDB := r.data.DB(ctx).Preload("Routes")
+	if active {
+		localDB = localDB.Where("end_date IS NULL OR end_date > ?", time.Now())
+	}
+	if err := localDB.Order("start_date DESC, id DESC").Find(&accidentsDB).Error; err != nil {
+		return nil, err
+	}
+	accidents := make([]*biz.Accident, 0, len(accidentsDB))
+	for _, a := range accidentsDB {
+		accidents = append(accidents, a.modelToResponse())
+	}
+	return accidents, nil
+}
+
+// SetRoutes implements biz.AccidentRepo.
+func (r *accidentRepo) SetRoutes(ctx context.Context, id uint64, routeIDs []uint32) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Where(&AccidentRoute{AccidentID: id}).Delete(&AccidentRoute{}).Error; err != nil {
+			return err
+		}
+		if len(routeIDs) == 0 {
+			return nil
+		}
+		links := make([]AccidentRoute, 0, len(routeIDs))
+		for _, routeID := range routeIDs {
+			links = append(links, AccidentRoute{AccidentID: id, RouteID: routeID})
+		}
+		return db.Omit("Route").Create(&links).Error
+	})
+}
@@ -1,4 +1,153 @@
 package data
 
+import (
+	"bus-service/internal/biz"
+	"context"
+	"errors"
+)
+
+// minSamples минимум записей истории для обучения модели на выборке
+const minSamples = 3
+
+// AiRoute прогнозирует расход батареи на рейс линейной регрессией
+// расхода по пробегу и длительности, обученной на HistoryBattery
 type AiRoute struct {
+	data *Data
+}
+
+func NewAiRoute(data *Data) biz.EnergyPredictor {
+	return &AiRoute{data: data}
+}
+
+// Estimate implements biz.EnergyPredictor.
+// Модель обучается на самой узкой выборке с достаточным числом записей:
+// автобус на маршруте, затем маршрут, затем весь парк.
+func (a *AiRoute) Estimate(ctx context.Context, route *biz.Route, busID *uint32) (*biz.EnergyEstimate, error) {
+	distance, duration := route.DistanceKm(), float32(0)
+	for _, t := range route.Time {
+		duration += t
+	}
+
+	filters := make([]HistoryBattery, 0, 3)
+	if busID != nil {
+		filters = append(filters, HistoryBattery{RouteID: route.Id, BusID: *busID})
+	}
+	filters = append(filters, HistoryBattery{RouteID: route.Id}, HistoryBattery{})
+
+	var history []HistoryBattery
+	for _, filter := range filters {
+		history = nil
+		err := a.data.DB(ctx).Where(&filter).Where("route_distance > 0").Find(&history).Error
+		if err != nil {
+			return nil, err
+		}
+		if len(history) >= minSamples {
+			break
+		}
+	}
+	if len(history) == 0 {
+		return nil, errors.New("NOT_ENOUGH_HISTORY")
+	}
+
+	usage := fitUsage(history, duration > 0).predict(float64(distance), float64(duration))
+	if usage < 0 {
+		usage = 0
+	}
+	return &biz.EnergyEstimate{
+		RouteID:  route.Id,
+		BusID:    busID,
+		Distance: distance,
+		Duration: duration,
+		Usage:    float32(usage),
+		Samples:  len(history),
+	}, nil
+}
+
+type linearModel struct {
+	intercept float64
+	slope     float64
+	// durationSlope расход за секунду, ноль для модели только по пробегу
+	durationSlope float64
+}
+
+func (m linearModel) predict(distance, duration float64) float64 {
+	return m.intercept + m.slope*distance + m.durationSlope*duration
+}
+
+// fitUsage подбирает модель расхода. Длительность учитывается, если она известна
+// для рейса и записей с ней в выборке больше, чем параметров модели
+func fitUsage(history []HistoryBattery, useDuration bool) linearModel {
+	if useDuration {
+		timed := make([]HistoryBattery, 0, len(history))
+		for _, h := range history {
+			if h.Duration > 0 {
+				timed = append(timed, h)
+			}
+		}
+		if len(timed) > minSamples {
+			if model, ok := fitUsageDuration(timed); ok {
+				return model
+			}
+		}
+	}
+	return fitUsageDistance(history)
+}
+
+// fitUsageDistance подбирает расход = a + b * пробег методом наименьших квадратов.
+// Если пробеги в выборке одинаковые, расход считается пропорциональным пробегу.
+func fitUsageDistance(history []HistoryBattery) linearModel {
+	n := float64(len(history))
+	var sumX, sumY, sumXX, sumXY float64
+	for _, h := range history {
+		x, y := float64(h.RouteDistance), float64(h.BatterUsage)
+		sumX += x
+		sumY += y
+		sumXX += x * x
+		sumXY += x * y
+	}
+	denominator := n*sumXX - sumX*sumX
+	if len(history) < minSamples || denominator < 1e-9 {
+		return linearModel{slope: sumY / sumX}
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	return linearModel{
+		intercept: (sumY - slope*sumX) / n,
+		slope:     slope,
+	}
+}
+
+// fitUsageDuration подбирает расход = a + b * пробег + c * длительность методом
+// наименьших квадратов. Если пробег и длительность в выборке линейно зависимы,
+// модель не строится.
+func fitUsageDuration(history []HistoryBattery) (linearModel, bool) {
+	n := float64(len(history))
+	var meanX, meanT, meanY float64
+	for _, h := range history {
+		meanX += float64(h.RouteDistance)
+		meanT += float64(h.Duration)
+		meanY += float64(h.BatterUsage)
+	}
+	meanX, meanT, meanY = meanX/n, meanT/n, meanY/n
+	var sXX, sTT, sXT, sXY, sTY float64
+	for _, h := range history {
+		x := float64(h.RouteDistance) - meanX
+		t := float64(h.Duration) - meanT
+		y := float64(h.BatterUsage) - meanY
+		sXX += x * x
+		sTT += t * t
+		sXT += x * t
+		sXY += x * y
+		sTY += t * y
+	}
+	det := sXX*sTT - sXT*sXT
+	if sXX == 0 || sTT == 0 || det < 1e-9*sXX*sTT {
+		return linearModel{}, false
+	}
+	slope := (sTT*sXY - sXT*sTY) / det
+	durationSlope := (sXX*sTY - sXT*sXY) / det
+	return linearModel{
+		intercept:     meanY - slope*meanX - durationSlope*meanT,
+		slope:         slope,
+		durationSlope: durationSlope,
+	}, true
 }
@@ -5,6 +5,8 @@ import (
 	"context"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Bus struct {
@@ -23,9 +25,9 @@ type busRepo struct {
 }
 
 // GetActiveBus implements biz.BusRepo.
-func (r *busRepo) GetActiveBus(context.Context) ([]*biz.Bus, error) {
+func (r *busRepo) GetActiveBus(ctx context.Context) ([]*biz.Bus, error) {
 	var busDB []Bus
-	localDB := r.data.db.Model(&Bus{})
+	localDB := r.data.DB(ctx).Model(&Bus{})
 	if err := localDB.Preload("Route").Where("driver_id IS NOT NULL").Find(&busDB).Error; err != nil {
 		return nil, err
 	}
@@ -46,31 +48,43 @@ func NewBusRepo(data *Data, logger log.Logger) biz.BusRepo {
 func (r *busRepo) Create(ctx context.Context, bus *biz.BusDTO) error {
 	var busDB Bus
 	busDB.RouteID = bus.RouteID
-	busDB.DriverID = bus.DriverID
-	if err := r.data.db.Create(&busDB).Error; err != nil {
+	busDB.Number = bus.Number
+	busDB.Status = string(bus.Status)
+	if err := r.data.DB(ctx).Create(&busDB).Error; err != nil {
 		return err
 	}
+	bus.Id = busDB.Id
 	return nil
 }
 
 // Delete implements biz.BusRepo.
 func (r *busRepo) Delete(ctx context.Context, id uint32) error {
-	return r.data.db.Delete(&Bus{}, id).Error
+	return r.data.DB(ctx).Delete(&Bus{}, id).Error
 }
 
 // GetById implements biz.BusRepo.
 func (r *busRepo) GetById(ctx context.Context, id uint32) (*biz.Bus, error) {
 	var busDB Bus
-	if err := r.data.db.Preload("Route").Where(&Bus{Id: id}).Find(&busDB).Error; err != nil {
+	if err := r.data.DB(ctx).Preload("Route").Where(&Bus{Id: id}).First(&busDB).Error; err != nil {
 		return nil, err
 	}
 	return r.modelToResponse(busDB), nil
 }
 
+// GetByIdForUpdate implements biz.BusRepo.
+func (r *busRepo) GetByIdForUpdate(ctx context.Context, id uint32) (*biz.Bus, error) {
+	// SELECT ... FOR UPDATE держит блокировку только внутри ExecTx
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where(&Bus{Id: id}).First(&Bus{}).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetById(ctx, id)
+}
+
 // List implements biz.BusRepo.
 func (r *busRepo) List(ctx context.Context) ([]*biz.Bus, int64, error) {
 	var busDB []Bus
-	localDB := r.data.db.Model(&Bus{})
+	localDB := r.data.DB(ctx).Model(&Bus{})
 	if err := localDB.Preload("Route").Find(&busDB).Error; err != nil {
 		return nil, 0, err
 	}
@@ -87,32 +101,74 @@ func (r *busRepo) List(ctx context.Context) ([]*biz.Bus, int64, error) {
 func (r *busRepo) Update(ctx context.Context, bus *biz.BusDTO) error {
 	var busDB Bus
 	busDB.RouteID = bus.RouteID
-	busDB.DriverID = bus.DriverID
+	busDB.Number = bus.Number
 	busDB.Id = bus.Id
-	if err := r.data.db.Save(&busDB).Error; err != nil {
-		return err
+	// статус и водитель меняются только через SetState, заряд через UpdateBattery
+	result := r.data.DB(ctx).Model(&busDB).Select("RouteID", "Number").Updates(&busDB)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// SetState implements biz.BusRepo.
+func (r *busRepo) SetState(ctx context.Context, id uint32, status biz.BusStatus, driverID *string) error {
+	return r.data.DB(ctx).Model(&Bus{Id: id}).Updates(map[string]interface{}{
+		"status":    string(status),
+		"driver_id": driverID,
+	}).Error
+}
+
+// RouteAndDriver implements biz.BusRepo.
+func (r *busRepo) RouteAndDriver(ctx context.Context, id uint32) (*uint32, *string, error) {
+	var busDB Bus
+	if err := r.data.DB(ctx).Select("id", "route_id", "driver_id").Where(&Bus{Id: id}).First(&busDB).Error; err != nil {
+		return nil, nil, err
+	}
+	return busDB.RouteID, busDB.DriverID, nil
+}
+
+// UpdateBattery implements biz.BusRepo.
+func (r *busRepo) UpdateBattery(ctx context.Context, id uint32, level uint) error {
+	result := r.data.DB(ctx).Model(&Bus{Id: id}).Update("battery_level", level)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
 	return nil
 }
 
 func (r *busRepo) modelToResponse(b Bus) *biz.Bus {
 	dto := &biz.Bus{
-		Id:      b.Id,
-		RouteID: b.RouteID,
+		Id:           b.Id,
+		RouteID:      b.RouteID,
+		DriverID:     b.DriverID,
+		Number:       b.Number,
+		Status:       biz.BusStatus(b.Status),
+		BatteryLevel: b.BatteryLevel,
 	}
 	if b.DriverID != nil {
-		user, _ := r.data.keycloak.GetUserByID(*b.DriverID)
-		dto.Driver = biz.BusUser{
-			Username:  user.Username,
-			FirstName: user.FirstName,
-			LastName:  user.LastName,
-			Email:     user.Email,
-			Id:        user.ID,
+		dto.Driver = biz.BusUser{Id: b.DriverID}
+		// без Keycloak в ответе остается только id водителя
+		if user, err := r.data.keycloak.GetUserByID(*b.DriverID); err != nil {
+			r.logger.Errorf("bus %d: driver %s: %s", b.Id, *b.DriverID, err)
+		} else {
+			dto.Driver = biz.BusUser{
+				Username:  user.Username,
+				FirstName: user.FirstName,
+				LastName:  user.LastName,
+				Email:     user.Email,
+				Id:        user.ID,
+			}
 		}
 	}
 	if b.Route != nil {
 		dto.Route = b.Route.modelToResponse()
 	}
-	//ignore err
 	return dto
 }
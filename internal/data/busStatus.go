@@ -0,0 +1,87 @@
+package data
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BusStatusHistory история смены статусов автобуса
+type BusStatusHistory struct {
+	Id        uint64 `gorm:"primaryKey"`
+	BusID     uint32 `gorm:"index"`
+	From      string
+	To        string
+	ChangedBy string
+	ChangedAt time.Time
+}
+
+func (m BusStatusHistory) modelToResponse() *biz.BusStatusChange {
+	return &biz.BusStatusChange{
+		Id:        m.Id,
+		BusID:     m.BusID,
+		From:      biz.BusStatus(m.From),
+		To:        biz.BusStatus(m.To),
+		ChangedBy: m.ChangedBy,
+		ChangedAt: m.ChangedAt,
+	}
+}
+
+// legacyBusStatus статусы, которые раньше хранились строками на русском
+var legacyBusStatus = map[string]biz.BusStatus{
+	"":            biz.BusStatusIdle,
+	"Не запущен":  biz.BusStatusIdle,
+	"Не в работе": biz.BusStatusIdle,
+	"В работе":    biz.BusStatusInService,
+	"На зарядке":  biz.BusStatusCharging,
+}
+
+// migrateBusStatus переводит старые статусы автобусов в biz.BusStatus
+func migrateBusStatus(db *gorm.DB) error {
+	for legacy, status := range legacyBusStatus {
+		err := db.Model(&Bus{}).Where("status = ?", legacy).Update("status", string(status)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type busStatusRepo struct {
+	data *Data
+}
+
+func NewBusStatusRepo(data *Data) biz.BusStatusRepo {
+	return &busStatusRepo{data: data}
+}
+
+// Create implements biz.BusStatusRepo.
+func (r *busStatusRepo) Create(ctx context.Context, change *biz.BusStatusChange) error {
+	historyDB := BusStatusHistory{
+		BusID:     change.BusID,
+		From:      string(change.From),
+		To:        string(change.To),
+		ChangedBy: change.ChangedBy,
+		ChangedAt: change.ChangedAt,
+	}
+	if err := r.data.DB(ctx).Create(&historyDB).Error; err != nil {
+		return err
+	}
+	change.Id = historyDB.Id
+	return nil
+}
+
+// List implements biz.BusStatusRepo.
+func (r *busStatusRepo) List(ctx context.Context, busID uint32) ([]*biz.BusStatusChange, error) {
+	var historyDB []BusStatusHistory
+	if err := r.data.DB(ctx).Where(&BusStatusHistory{BusID: busID}).Order("changed_at DESC").Find(&historyDB).Error; err != nil {
+		return nil, err
+	}
+	history := make([]*biz.BusStatusChange, 0)
+	for _, h := range historyDB {
+		history = append(history, h.modelToResponse())
+	}
+	return history, nil
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"bus-service/internal/biz"
 	"bus-service/internal/conf"
+	"bus-service/pkg/rabbit"
 	"context"
 	"crypto/tls"
 	"fmt"
@@ -28,6 +29,7 @@ import (
 var ProviderSet = wire.NewSet(
 	NewData,
 	NewDB,
+	NewTransaction,
 	NewKeycloak,
 	NewKeyCloakAPI,
 	NewBusRepo,
@@ -37,14 +39,24 @@ var ProviderSet = wire.NewSet(
 	NewRabbit,
 	NewDriverRepo,
 	NewShiftRepo,
+	NewPositionRepo,
+	NewBatteryRepo,
+	NewAiRoute,
+	NewBusStatusRepo,
+	NewRegulation,
+	NewRosterRepo,
+	NewTimetableRepo,
+	NewGtfsAgency,
+	NewAccidentRepo,
+	NewOutboxRepo,
+	NewOutboxPublisher,
+	wire.Bind(new(biz.RoleChecker), new(*KeycloakAPI)),
 )
 
 // Data структура для работы с базой данных
 type Data struct {
 	db       *gorm.DB //Реализация работы с базой данной через библиотеку gorm
 	keycloak *KeycloakAPI
-
-	// node *centrifuge.Node
 }
 
 // NewData создания экземпляра для работы с базой данных
@@ -69,11 +81,40 @@ func (d *Data) DB(ctx context.Context) *gorm.DB {
 	return d.db
 }
 
+type contextAfterCommitKey struct{}
+
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	hooks, nested := ctx.Value(contextAfterCommitKey{}).(*[]func())
+	if !nested {
+		hooks = &[]func(){}
+		ctx = context.WithValue(ctx, contextAfterCommitKey{}, hooks)
+	}
+	registered := len(*hooks)
+	err := d.DB(ctx).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		ctx = context.WithValue(ctx, contextTxKey{}, tx)
 		return fn(ctx)
 	})
+	if err != nil {
+		// откат вложенной транзакции отменяет и ее хуки
+		*hooks = (*hooks)[:registered]
+		return err
+	}
+	if nested {
+		return nil
+	}
+	for _, hook := range *hooks {
+		hook()
+	}
+	return nil
+}
+
+func (d *Data) AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(contextAfterCommitKey{}).(*[]func())
+	if !ok {
+		fn()
+		return
+	}
+	*hooks = append(*hooks, fn)
 }
 
 func NewKeycloak(c *conf.Data) *gocloak.GoCloak {
@@ -109,7 +150,18 @@ func NewDB(c *conf.Data) *gorm.DB {
 		log.Errorf("failed opening connection to postgres: %v", err)
 		panic("failed to connect database")
 	}
-	db.AutoMigrate(&Bus{}, &Route{}, &Stations{}, &Shift{})
+	db.SetupJoinTable(&Route{}, "Stations", &RouteStations{})
+	db.SetupJoinTable(&Stations{}, "Routes", &RouteStations{})
+	if err := migrateStations(db); err != nil {
+		log.Errorf("failed migrating stations: %v", err)
+	}
+	err = db.AutoMigrate(&Bus{}, &Route{}, &Stations{}, &Shift{}, &BusPosition{}, &PositionHistory{}, &HistoryBattery{}, &BusStatusHistory{}, &Assignment{}, &Timetable{}, &Accident{}, &AccidentRoute{}, &Outbox{})
+	if err != nil {
+		log.Errorf("failed auto migrating: %v", err)
+	}
+	if err := migrateBusStatus(db); err != nil {
+		log.Errorf("failed migrating bus statuses: %v", err)
+	}
 	return db
 }
 
@@ -125,26 +177,36 @@ func NewMapService(c *conf.Data) mapS.MapClient {
 	if err != nil {
 		panic(err)
 	}
-	return mapS.NewMapClient(conn)
+	return newRetryMapClient(mapS.NewMapClient(conn))
 }
 
-func NewRabbit(c *conf.Data) *biz.RabbitData {
-	conn, err := amqp.Dial(c.Rabbit)
-	if err != nil {
-		panic(err)
-	}
+// NewRabbit создает подключение к RabbitMQ. Подключается оно при старте
+// сервера, очереди объявляются заново при каждом переподключении
+func NewRabbit(c *conf.Data, logger log.Logger) *biz.RabbitData {
+	conn := rabbit.NewRabbitConn(c.Rabbit, logger)
+	conn.Declare(declareQueues)
+	return &biz.RabbitData{Conn: conn}
+}
 
+func declareQueues(conn *amqp.Connection) error {
+	for _, queue := range []string{"accident", "telemetry", "social", "regulation"} {
+		if err := declareDurable(conn, queue); err != nil {
+			return err
+		}
+	}
 	ch, err := conn.Channel()
 	if err != nil {
-		panic(err)
+		return err
 	}
-	ch.QueueDeclare(
-		"accident", // name
-		false,      // durable
-		false,      // delete when unused
-		false,      // exclusive
-		false,      // no-wait
-		nil,        // arguments
+	defer ch.Close()
+	// очереди под события создают и привязывают сами получатели
+	return ch.ExchangeDeclare(
+		biz.EventsExchange, // name
+		"topic",            // type
+		true,               // durable
+		false,              // auto-deleted
+		false,              // internal
+		false,              // no-wait
+		nil,                // arguments
 	)
-	return &biz.RabbitData{Ch: ch, Conn: conn}
 }
@@ -0,0 +1,26 @@
+package data
+
+import (
+	"bus-service/internal/biz"
+	"bus-service/internal/conf"
+	"fmt"
+	"time"
+)
+
+func NewGtfsAgency(c *conf.Data) (*biz.GtfsAgency, error) {
+	agency := c.GetGtfs()
+	location := time.Local
+	if tz := agency.GetTimezone(); tz != "" {
+		var err error
+		location, err = time.LoadLocation(tz)
+		if err != nil {
+			return nil, fmt.Errorf("gtfs timezone: %w", err)
+		}
+	}
+	return &biz.GtfsAgency{
+		Name:     agency.GetAgencyName(),
+		URL:      agency.GetAgencyUrl(),
+		Timezone: agency.GetTimezone(),
+		Location: location,
+	}, nil
+}
@@ -1,11 +1,74 @@
 package data
 
-import "time"
+import (
+	"bus-service/internal/biz"
+	"context"
+	"time"
+)
 
 type HistoryBattery struct {
 	Id            uint
-	Date          time.Time
+	Date          time.Time `gorm:"index"`
 	RouteDistance float32
+	Duration      float32
 	BatterUsage   float32
-	RouteID       uint32
+	RouteID       uint32 `gorm:"index"`
+	BusID         uint32 `gorm:"index"`
+}
+
+func (m HistoryBattery) modelToResponse() *biz.BatteryHistory {
+	return &biz.BatteryHistory{
+		Id:            m.Id,
+		BusID:         m.BusID,
+		RouteID:       m.RouteID,
+		Date:          m.Date,
+		RouteDistance: m.RouteDistance,
+		Duration:      m.Duration,
+		BatterUsage:   m.BatterUsage,
+	}
+}
+
+type batteryRepo struct {
+	data *Data
+}
+
+func NewBatteryRepo(data *Data) biz.BatteryRepo {
+	return &batteryRepo{data: data}
+}
+
+// Create implements biz.BatteryRepo.
+func (r *batteryRepo) Create(ctx context.Context, history *biz.BatteryHistory) error {
+	historyDB := HistoryBattery{
+		Date:          history.Date,
+		RouteDistance: history.RouteDistance,
+		Duration:      history.Duration,
+		BatterUsage:   history.BatterUsage,
+		RouteID:       history.RouteID,
+		BusID:         history.BusID,
+	}
+	if err := r.data.DB(ctx).Create(&historyDB).Error; err != nil {
+		return err
+	}
+	history.Id = historyDB.Id
+	return nil
+}
+
+// List implements biz.BatteryRepo.
+func (r *batteryRepo) List(ctx context.Context, busID uint32, from, to *time.Time) ([]*biz.BatteryHistory, error) {
+	var historyDB []HistoryBattery
+	localDB := r.data.DB(ctx).Where(&HistoryBattery{BusID: busID})
+	if from != nil {
+		localDB = localDB.Where("date >= ?", *from)
+	}
+	if to != nil {
+		localDB = localDB.Where("date <= ?", *to)
+	}
+	if err := localDB.Order("date").Find(&historyDB).Error; err != nil {
+		return nil, err
+	}
+	history := make([]*biz.BatteryHistory, 0)
+	for _, h := range historyDB {
+		history = append(history, h.modelToResponse())
+	}
+	return history, nil
 }
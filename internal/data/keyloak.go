@@ -80,3 +80,30 @@ func (api *KeycloakAPI) GetDrivers(roleName string) ([]*gocloak.User, error) {
 		gocloak.GetUsersByRoleParams{},
 	)
 }
+
+// HasRealmRole проверяет, назначена ли пользователю роль realm
+func (api *KeycloakAPI) HasRealmRole(userId string, roleName string) (bool, error) {
+	token, err := api.client.LoginAdmin(
+		context.TODO(),
+		api.username,
+		api.password,
+		api.realm)
+	if err != nil {
+		return false, err
+	}
+	roles, err := api.client.GetCompositeRealmRolesByUserID(
+		context.TODO(),
+		token.AccessToken,
+		api.realm,
+		userId,
+	)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if role.Name != nil && *role.Name == roleName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
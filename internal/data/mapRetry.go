@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	mapS "bus-service/api/map/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mapRetryAttempts = 3
+	mapRetryBackoff  = 100 * time.Millisecond
+)
+
+// retryMapClient повторяет запросы к map-service при временных ошибках
+// с экспоненциальной задержкой
+type retryMapClient struct {
+	next     mapS.MapClient
+	attempts int
+	backoff  time.Duration
+}
+
+func newRetryMapClient(next mapS.MapClient) mapS.MapClient {
+	return &retryMapClient{next: next, attempts: mapRetryAttempts, backoff: mapRetryBackoff}
+}
+
+func (c *retryMapClient) GetPath(ctx context.Context, in *mapS.GetPathRequest, opts ...grpc.CallOption) (*mapS.PathResponse, error) {
+	var reply *mapS.PathResponse
+	err := c.retry(ctx, func() (err error) {
+		reply, err = c.next.GetPath(ctx, in, opts...)
+		return err
+	})
+	return reply, err
+}
+
+func (c *retryMapClient) CheckPath(ctx context.Context, in *mapS.CheckPathRequest, opts ...grpc.CallOption) (*mapS.CheckPathResponse, error) {
+	var reply *mapS.CheckPathResponse
+	err := c.retry(ctx, func() (err error) {
+		reply, err = c.next.CheckPath(ctx, in, opts...)
+		return err
+	})
+	return reply, err
+}
+
+func (c *retryMapClient) retry(ctx context.Context, call func() error) error {
+	var err error
+	for attempt := 0; attempt < c.attempts; attempt++ {
+		if attempt > 0 {
+			delay := c.backoff << (attempt - 1)
+			delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err = call(); err == nil || !retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+package data
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Outbox сообщения для RabbitMQ, ожидающие отправки
+type Outbox struct {
+	Id          uint64 `gorm:"primaryKey"`
+	Exchange    string
+	RoutingKey  string
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	SentAt      *time.Time `gorm:"index"`
+	Attempts    int        `gorm:"not null;default:0"`
+	LastError   string
+}
+
+func (Outbox) TableName() string {
+	return "outbox"
+}
+
+func (m Outbox) modelToResponse() *biz.OutboxMessage {
+	return &biz.OutboxMessage{
+		Id:          m.Id,
+		Exchange:    m.Exchange,
+		RoutingKey:  m.RoutingKey,
+		ContentType: m.ContentType,
+		Body:        m.Body,
+		CreatedAt:   m.CreatedAt,
+		SentAt:      m.SentAt,
+		Attempts:    m.Attempts,
+		LastError:   m.LastError,
+	}
+}
+
+type outboxRepo struct {
+	data *Data
+}
+
+func NewOutboxRepo(data *Data) biz.OutboxRepo {
+	return &outboxRepo{data: data}
+}
+
+// Create implements biz.OutboxRepo.
+func (r *outboxRepo) Create(ctx context.Context, message *biz.OutboxMessage) error {
+	outboxDB := Outbox{
+		Exchange:    message.Exchange,
+		RoutingKey:  message.RoutingKey,
+		ContentType: message.ContentType,
+		Body:        message.Body,
+		CreatedAt:   message.CreatedAt,
+	}
+	if err := r.data.DB(ctx).Create(&outboxDB).Error; err != nil {
+		return err
+	}
+	message.Id = outboxDB.Id
+	return nil
+}
+
+// Pending implements biz.OutboxRepo.
+func (r *outboxRepo) Pending(ctx context.Context, limit int) ([]*biz.OutboxMessage, error) {
+	var outboxDB []Outbox
+	err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("sent_at IS NULL").
+		Order("id").
+		Limit(limit).
+		Find(&outboxDB).Error
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*biz.OutboxMessage, 0, len(outboxDB))
+	for _, m := range outboxDB {
+		messages = append(messages, m.modelToResponse())
+	}
+	return messages, nil
+}
+
+// MarkSent implements biz.OutboxRepo.
+func (r *outboxRepo) MarkSent(ctx context.Context, ids []uint64, at time.Time) error {
+	return r.data.DB(ctx).Model(&Outbox{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+		"sent_at":  at,
+		"attempts": gorm.Expr("attempts + 1"),
+	}).Error
+}
+
+// MarkFailed implements biz.OutboxRepo.
+func (r *outboxRepo) MarkFailed(ctx context.Context, id uint64, reason string) error {
+	return r.data.DB(ctx).Model(&Outbox{Id: id}).Updates(map[string]interface{}{
+		"last_error": reason,
+		"attempts":   gorm.Expr("attempts + 1"),
+	}).Error
+}
+
+// Purge implements biz.OutboxRepo.
+func (r *outboxRepo) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result := r.data.DB(ctx).Where("sent_at < ?", before).Delete(&Outbox{})
+	return result.RowsAffected, result.Error
+}
+
+// confirmTimeout сколько ждать подтверждения брокера
+const confirmTimeout = 5 * time.Second
+
+var errNack = errors.New("NACK")
+
+// outboxPublisher публикует сообщения outbox в отдельном канале в режиме подтверждений
+type outboxPublisher struct {
+	rabbit *biz.RabbitData
+	mu     sync.Mutex
+	ch     *amqp.Channel
+}
+
+func NewOutboxPublisher(rabbit *biz.RabbitData) biz.OutboxPublisher {
+	return &outboxPublisher{rabbit: rabbit}
+}
+
+// channel открывает канал заново, если прежний закрыт
+func (p *outboxPublisher) channel() (*amqp.Channel, error) {
+	if p.ch != nil && !p.ch.IsClosed() {
+		return p.ch, nil
+	}
+	ch, err := p.rabbit.Conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, err
+	}
+	p.ch = ch
+	return ch, nil
+}
+
+// Publish implements biz.OutboxPublisher.
+func (p *outboxPublisher) Publish(ctx context.Context, messages []*biz.OutboxMessage) []error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	errs := make([]error, len(messages))
+	ch, err := p.channel()
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	confirms := make([]*amqp.DeferredConfirmation, len(messages))
+	for i, message := range messages {
+		confirms[i], errs[i] = ch.PublishWithDeferredConfirmWithContext(ctx,
+			message.Exchange,
+			message.RoutingKey,
+			false,
+			false,
+			amqp.Publishing{
+				ContentType:  message.ContentType,
+				Body:         message.Body,
+				DeliveryMode: amqp.Persistent,
+				// по MessageId получатели отбрасывают повторную доставку
+				MessageId: strconv.FormatUint(message.Id, 10),
+				Timestamp: message.CreatedAt,
+			})
+	}
+	ctx, cancel := context.WithTimeout(ctx, confirmTimeout)
+	defer cancel()
+	for i, confirm := range confirms {
+		if errs[i] != nil {
+			continue
+		}
+		ack, err := confirm.WaitContext(ctx)
+		if err != nil {
+			errs[i] = err
+		} else if !ack {
+			errs[i] = errNack
+		}
+	}
+	return errs
+}
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// BusPosition последнее известное положение автобуса
+type BusPosition struct {
+	BusID     uint32 `gorm:"primaryKey;autoIncrement:false"`
+	RouteID   *uint32
+	Lat       float64
+	Lon       float64
+	Speed     float32
+	Heading   float32
+	Timestamp time.Time
+}
+
+// PositionHistory все принятые GPS-отметки
+type PositionHistory struct {
+	Id        uint64 `gorm:"primaryKey"`
+	BusID     uint32 `gorm:"index:idx_position_history_bus_time"`
+	RouteID   *uint32
+	Lat       float64
+	Lon       float64
+	Speed     float32
+	Heading   float32
+	Timestamp time.Time `gorm:"index:idx_position_history_bus_time"`
+}
+
+func (m BusPosition) modelToResponse() *biz.BusPosition {
+	return &biz.BusPosition{
+		BusID:     m.BusID,
+		RouteID:   m.RouteID,
+		Lat:       m.Lat,
+		Lon:       m.Lon,
+		Speed:     m.Speed,
+		Heading:   m.Heading,
+		Timestamp: m.Timestamp,
+	}
+}
+
+type positionRepo struct {
+	data *Data
+}
+
+func NewPositionRepo(data *Data) biz.PositionRepo {
+	return &positionRepo{data: data}
+}
+
+// Save implements biz.PositionRepo.
+func (r *positionRepo) Save(ctx context.Context, position *biz.BusPosition) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		history := PositionHistory{
+			BusID:     position.BusID,
+			RouteID:   position.RouteID,
+			Lat:       position.Lat,
+			Lon:       position.Lon,
+			Speed:     position.Speed,
+			Heading:   position.Heading,
+			Timestamp: position.Timestamp,
+		}
+		if err := db.Create(&history).Error; err != nil {
+			return err
+		}
+		latest := BusPosition{
+			BusID:     position.BusID,
+			RouteID:   position.RouteID,
+			Lat:       position.Lat,
+			Lon:       position.Lon,
+			Speed:     position.Speed,
+			Heading:   position.Heading,
+			Timestamp: position.Timestamp,
+		}
+		// отметки, пришедшие с опозданием, не перезаписывают более свежее положение
+		return db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "bus_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"route_id", "lat", "lon", "speed", "heading", "timestamp"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "bus_positions.timestamp < excluded.timestamp"},
+			}},
+		}).Create(&latest).Error
+	})
+}
+
+// GetByBusID implements biz.PositionRepo.
+func (r *positionRepo) GetByBusID(ctx context.Context, busID uint32) (*biz.BusPosition, error) {
+	var positionDB BusPosition
+	if err := r.data.DB(ctx).Where(&BusPosition{BusID: busID}).First(&positionDB).Error; err != nil {
+		return nil, err
+	}
+	return positionDB.modelToResponse(), nil
+}
+
+// List implements biz.PositionRepo.
+func (r *positionRepo) List(ctx context.Context) ([]*biz.BusPosition, error) {
+	var positionDB []BusPosition
+	if err := r.data.DB(ctx).Order("bus_id").Find(&positionDB).Error; err != nil {
+		return nil, err
+	}
+	positions := make([]*biz.BusPosition, 0)
+	for _, p := range positionDB {
+		positions = append(positions, p.modelToResponse())
+	}
+	return positions, nil
+}
+
+// History implements biz.PositionRepo.
+func (r *positionRepo) History(ctx context.Context, busID uint32, from, to time.Time) ([]*biz.BusPosition, error) {
+	var historyDB []PositionHistory
+	err := r.data.DB(ctx).Where(&PositionHistory{BusID: busID}).
+		Where("timestamp BETWEEN ? AND ?", from, to).
+		Order("timestamp").
+		Find(&historyDB).Error
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*biz.BusPosition, 0, len(historyDB))
+	for _, h := range historyDB {
+		positions = append(positions, &biz.BusPosition{
+			BusID:     h.BusID,
+			RouteID:   h.RouteID,
+			Lat:       h.Lat,
+			Lon:       h.Lon,
+			Speed:     h.Speed,
+			Heading:   h.Heading,
+			Timestamp: h.Timestamp,
+		})
+	}
+	return positions, nil
+}
+
+// Live implements biz.PositionRepo.
+func (r *positionRepo) Live(ctx context.Context, since time.Time) ([]*biz.VehicleState, error) {
+	var rows []struct {
+		BusPosition
+		Number     string
+		Status     string
+		BusRouteID *uint32
+	}
+	err := r.data.DB(ctx).Model(&BusPosition{}).
+		Select("bus_positions.*, buses.number, buses.status, buses.route_id AS bus_route_id").
+		Joins("JOIN buses ON buses.id = bus_positions.bus_id").
+		Where("bus_positions.timestamp >= ?", since).
+		Order("bus_positions.bus_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	states := make([]*biz.VehicleState, 0, len(rows))
+	for _, row := range rows {
+		states = append(states, &biz.VehicleState{
+			Position: row.BusPosition.modelToResponse(),
+			Number:   row.Number,
+			Status:   biz.BusStatus(row.Status),
+			RouteID:  row.BusRouteID,
+		})
+	}
+	return states, nil
+}
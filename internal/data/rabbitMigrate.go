@@ -0,0 +1,158 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// migrateAttempts сколько раз пытаемся удалить старую очередь, пока в нее пишут
+const migrateAttempts = 10
+
+func migrateQueue(queue string) string {
+	return queue + ".migrate"
+}
+
+// declareDurable объявляет долговечную очередь. Прежние версии объявляли очереди
+// недолговечными: сообщения такой очереди переносятся во временную <queue>.migrate,
+// очередь пересоздается и сообщения возвращаются. Прерванный перенос
+// завершается при следующем подключении
+func declareDurable(conn *amqp.Connection, queue string) error {
+	err := declareQueue(conn, queue)
+	if isAmqpCode(err, amqp.PreconditionFailed) {
+		err = migrateDurable(conn, queue)
+	}
+	if err != nil {
+		return err
+	}
+	return restoreMigrated(conn, queue)
+}
+
+func declareQueue(conn *amqp.Connection, queue string) error {
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+	_, err = ch.QueueDeclare(
+		queue, // name
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	return err
+}
+
+func isAmqpCode(err error, code int) bool {
+	var amqpErr *amqp.Error
+	return errors.As(err, &amqpErr) && amqpErr.Code == code
+}
+
+func confirmChannel(conn *amqp.Connection) (*amqp.Channel, error) {
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return nil, err
+	}
+	return ch, nil
+}
+
+func migrateDurable(conn *amqp.Connection, queue string) error {
+	if err := declareQueue(conn, migrateQueue(queue)); err != nil {
+		return err
+	}
+	for attempt := 0; ; attempt++ {
+		if attempt == migrateAttempts {
+			// сервис продолжает работать со старой очередью, перенос повторится
+			// при следующем подключении
+			log.Warnf("queue %s: messages keep arriving, migration to durable postponed", queue)
+			return nil
+		}
+		ch, err := confirmChannel(conn)
+		if err != nil {
+			return err
+		}
+		if err := moveMessages(ch, queue, migrateQueue(queue)); err != nil {
+			ch.Close()
+			return err
+		}
+		// удаляется только пустая очередь, сообщения, пришедшие во время
+		// переноса, переносятся на следующем проходе
+		_, err = ch.QueueDelete(queue, false, true, false)
+		ch.Close()
+		if err == nil {
+			break
+		}
+		if !isAmqpCode(err, amqp.PreconditionFailed) {
+			return err
+		}
+	}
+	return declareQueue(conn, queue)
+}
+
+// restoreMigrated возвращает в очередь сообщения, оставшиеся в <queue>.migrate
+func restoreMigrated(conn *amqp.Connection, queue string) error {
+	ch, err := confirmChannel(conn)
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+	if _, err := ch.QueueDeclarePassive(migrateQueue(queue), true, false, false, false, nil); err != nil {
+		if isAmqpCode(err, amqp.NotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := moveMessages(ch, migrateQueue(queue), queue); err != nil {
+		return err
+	}
+	_, err = ch.QueueDelete(migrateQueue(queue), false, true, false)
+	return err
+}
+
+// moveMessages перекладывает все сообщения из from в to, сообщение удаляется
+// из from только после подтверждения брокером публикации в to
+func moveMessages(ch *amqp.Channel, from, to string) error {
+	for {
+		d, ok, err := ch.Get(from, false)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		confirm, err := ch.PublishWithDeferredConfirmWithContext(context.Background(), "", to, false, false, amqp.Publishing{
+			Headers:       d.Headers,
+			ContentType:   d.ContentType,
+			Body:          d.Body,
+			DeliveryMode:  amqp.Persistent,
+			MessageId:     d.MessageId,
+			Timestamp:     d.Timestamp,
+			Type:          d.Type,
+			CorrelationId: d.CorrelationId,
+			ReplyTo:       d.ReplyTo,
+			AppId:         d.AppId,
+		})
+		if err != nil {
+			return err
+		}
+		ack, err := confirm.WaitContext(context.Background())
+		if err != nil {
+			return err
+		}
+		if !ack {
+			return fmt.Errorf("queue %s: broker rejected moved message", to)
+		}
+		if err := d.Ack(false); err != nil {
+			return err
+		}
+	}
+}
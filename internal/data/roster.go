@@ -0,0 +1,123 @@
+package data
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Assignment struct {
+	Id           uint32 `gorm:"primaryKey"`
+	DriverID     string `gorm:"index"`
+	BusID        uint32 `gorm:"index"`
+	Bus          *Bus   `gorm:"constraint:OnDelete:CASCADE"`
+	RouteID      *uint32
+	Route        *Route    `gorm:"constraint:OnDelete:SET NULL"`
+	PlannedStart time.Time `gorm:"index"`
+	PlannedEnd   time.Time
+}
+
+func (m Assignment) modelToResponse() *biz.Assignment {
+	return &biz.Assignment{
+		Id:           m.Id,
+		DriverID:     m.DriverID,
+		BusID:        m.BusID,
+		RouteID:      m.RouteID,
+		PlannedStart: m.PlannedStart,
+		PlannedEnd:   m.PlannedEnd,
+	}
+}
+
+type rosterRepo struct {
+	data *Data
+}
+
+func NewRosterRepo(data *Data) biz.RosterRepo {
+	return &rosterRepo{data: data}
+}
+
+func assignmentToModel(assignment *biz.Assignment) Assignment {
+	return Assignment{
+		Id:           assignment.Id,
+		DriverID:     assignment.DriverID,
+		BusID:        assignment.BusID,
+		RouteID:      assignment.RouteID,
+		PlannedStart: assignment.PlannedStart,
+		PlannedEnd:   assignment.PlannedEnd,
+	}
+}
+
+// Create implements biz.RosterRepo.
+func (r *rosterRepo) Create(ctx context.Context, assignment *biz.Assignment) error {
+	assignmentDB := assignmentToModel(assignment)
+	if err := r.data.DB(ctx).Create(&assignmentDB).Error; err != nil {
+		return err
+	}
+	assignment.Id = assignmentDB.Id
+	return nil
+}
+
+// Update implements biz.RosterRepo.
+func (r *rosterRepo) Update(ctx context.Context, assignment *biz.Assignment) error {
+	assignmentDB := assignmentToModel(assignment)
+	return r.data.DB(ctx).Omit("Bus", "Route").Save(&assignmentDB).Error
+}
+
+// Delete implements biz.RosterRepo.
+func (r *rosterRepo) Delete(ctx context.Context, id uint32) error {
+	result := r.data.DB(ctx).Delete(&Assignment{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetById implements biz.RosterRepo.
+func (r *rosterRepo) GetById(ctx context.Context, id uint32) (*biz.Assignment, error) {
+	var assignmentDB Assignment
+	if err := r.data.DB(ctx).Where(&Assignment{Id: id}).First(&assignmentDB).Error; err != nil {
+		return nil, err
+	}
+	return assignmentDB.modelToResponse(), nil
+}
+
+// List implements biz.RosterRepo.
+func (r *rosterRepo) List(ctx context.Context, filter *biz.AssignmentFilter) ([]*biz.Assignment, error) {
+	var assignmentsDB []Assignment
+	localDB := r.data.DB(ctx)
+	if filter.DriverID != nil {
+		localDB = localDB.Where("driver_id = ?", *filter.DriverID)
+	}
+	if filter.BusID != nil {
+		localDB = localDB.Where("bus_id = ?", *filter.BusID)
+	}
+	if filter.From != nil {
+		localDB = localDB.Where("planned_end > ?", *filter.From)
+	}
+	if filter.To != nil {
+		localDB = localDB.Where("planned_start < ?", *filter.To)
+	}
+	if err := localDB.Order("planned_start").Find(&assignmentsDB).Error; err != nil {
+		return nil, err
+	}
+	assignments := make([]*biz.Assignment, 0, len(assignmentsDB))
+	for _, a := range assignmentsDB {
+		assignments = append(assignments, a.modelToResponse())
+	}
+	return assignments, nil
+}
+
+// Next implements biz.RosterRepo.
+func (r *rosterRepo) Next(ctx context.Context, driverID string, after time.Time) (*biz.Assignment, error) {
+	var assignmentDB Assignment
+	err := r.data.DB(ctx).Where("driver_id = ? AND planned_end > ?", driverID, after).Order("planned_start").First(&assignmentDB).Error
+	if err != nil {
+		return nil, err
+	}
+	return assignmentDB.modelToResponse(), nil
+}
@@ -3,7 +3,6 @@ package data
 import (
 	"bus-service/internal/biz"
 	"context"
-	"fmt"
 
 	"github.com/go-kratos/kratos/v2/log"
 	pq "github.com/lib/pq"
@@ -62,41 +61,63 @@ func (r *routeRepo) Create(ctx context.Context, route *biz.Route) error {
 	routeDB.Path = route.Path
 	routeDB.Time = route.Time
 	routeDB.Lengths = route.Lengths
-	stations := make([]Stations, 0)
-	for _, station := range route.Stations {
-		stations = append(stations, Stations{
-			Name: station.Name,
-			Lat:  station.Lat,
-			Lon:  station.Lon,
-		})
-	}
 	routeDB.Length = route.Length
-	routeDB.Stations = stations
-	if err := r.data.db.Create(&routeDB).Error; err != nil {
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Omit("Stations").Create(&routeDB).Error; err != nil {
+			return err
+		}
+		stations, err := findOrCreateStations(db, route.Stations)
+		if err != nil {
+			return err
+		}
+		return replaceRouteStations(db, routeDB.Id, stations)
+	})
+	if err != nil {
 		return err
 	}
+	route.Id = routeDB.Id
 	return nil
 }
 
 // Delete implements biz.RouteRepo.
 func (r *routeRepo) Delete(ctx context.Context, id uint32) error {
-	return r.data.db.Delete(&Route{}, id).Error
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Where(&RouteStations{RouteID: id}).Delete(&RouteStations{}).Error; err != nil {
+			return err
+		}
+		// внешние ключи при миграции не создаются, связи с ДТП чистим сами
+		if err := db.Where(&AccidentRoute{RouteID: id}).Delete(&AccidentRoute{}).Error; err != nil {
+			return err
+		}
+		return db.Delete(&Route{}, id).Error
+	})
 }
 
 // GetById implements biz.RouteRepo.
 func (r *routeRepo) GetById(ctx context.Context, id uint32) (*biz.Route, error) {
 	var routeDB Route
-	if err := r.data.db.Preload("Stations").Where(&Route{Id: id}).Find(&routeDB).Error; err != nil {
+	db := r.data.DB(ctx)
+	if err := db.Where(&Route{Id: id}).First(&routeDB).Error; err != nil {
+		return nil, err
+	}
+	routes := []Route{routeDB}
+	if err := loadRouteStations(db, routes); err != nil {
 		return nil, err
 	}
-	return routeDB.modelToResponse(), nil
+	return routes[0].modelToResponse(), nil
 }
 
 // List implements biz.RouteRepo.
-func (r *routeRepo) List(context.Context) ([]*biz.Route, int64, error) {
+func (r *routeRepo) List(ctx context.Context) ([]*biz.Route, int64, error) {
 	var routeDB []Route
-	localDB := r.data.db.Model(&Route{})
-	if err := localDB.Preload("Stations").Find(&routeDB).Error; err != nil {
+	db := r.data.DB(ctx)
+	localDB := db.Model(&Route{})
+	if err := localDB.Order("id").Find(&routeDB).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := loadRouteStations(db, routeDB); err != nil {
 		return nil, 0, err
 	}
 	var count int64
@@ -105,7 +126,6 @@ func (r *routeRepo) List(context.Context) ([]*biz.Route, int64, error) {
 	for _, b := range routeDB {
 		route = append(route, b.modelToResponse())
 	}
-	fmt.Println(route[0].Stations)
 	return route, count, nil
 }
 
@@ -118,18 +138,19 @@ func (r *routeRepo) Update(ctx context.Context, route *biz.Route) error {
 	routeDB.Length = route.Length
 	routeDB.Lengths = route.Lengths
 	routeDB.Time = route.Time
-	stations := make([]Stations, 0)
-	for _, station := range route.Stations {
-		stations = append(stations, Stations{
-			ID:   station.ID,
-			Name: station.Name,
-			Lat:  station.Lat,
-			Lon:  station.Lon,
-		})
-	}
-	routeDB.Stations = stations
-	if err := r.data.db.Save(&route).Error; err != nil {
-		return err
-	}
-	return nil
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.First(&Route{}, route.Id).Error; err != nil {
+			return err
+		}
+		err := db.Model(&routeDB).Select("Number", "Path", "Length", "Lengths", "Time").Updates(&routeDB).Error
+		if err != nil {
+			return err
+		}
+		stations, err := findOrCreateStations(db, route.Stations)
+		if err != nil {
+			return err
+		}
+		return replaceRouteStations(db, route.Id, stations)
+	})
 }
@@ -2,24 +2,48 @@ package data
 
 import (
 	"bus-service/internal/biz"
+	"bus-service/internal/conf"
 	"context"
+	"errors"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 type Shift struct {
 	Id        uint32 `gorm:"primaryKey"`
 	StartTime time.Time
 	EndDate   *time.Time
-	DriverID  string
+	// открытая смена у водителя тоже только одна, индекс закрывает гонку
+	// между проверкой в biz и вставкой
+	DriverID     string `gorm:"uniqueIndex:idx_shifts_open_driver,where:end_date IS NULL"`
+	StartBattery *uint
+	// на автобусе может быть открыта только одна смена
+	BusID   *uint32 `gorm:"uniqueIndex:idx_shifts_open_bus,where:end_date IS NULL"`
+	Bus     *Bus    `gorm:"constraint:OnDelete:SET NULL"`
+	RouteID *uint32
+	Route   *Route `gorm:"constraint:OnDelete:SET NULL"`
+	Overrun bool   `gorm:"not null;default:false"`
 }
 
 func (m Shift) modelToResponse() *biz.Shift {
-	return &biz.Shift{
-		Id:        m.Id,
-		StartTime: m.StartTime,
-		EndDate:   m.EndDate,
-		DriverID:  m.DriverID,
+	dto := &biz.Shift{
+		Id:           m.Id,
+		StartTime:    m.StartTime,
+		EndDate:      m.EndDate,
+		DriverID:     m.DriverID,
+		StartBattery: m.StartBattery,
+		BusID:        m.BusID,
+		RouteID:      m.RouteID,
+		Overrun:      m.Overrun,
+	}
+	if m.Bus != nil {
+		dto.BusNumber = &m.Bus.Number
+	}
+	if m.Route != nil {
+		dto.RouteNumber = &m.Route.Number
 	}
+	return dto
 }
 
 type shiftRepo struct {
@@ -36,16 +60,24 @@ func (r *shiftRepo) Create(ctx context.Context, shift *biz.Shift) error {
 	shiftDB.StartTime = shift.StartTime
 	shiftDB.EndDate = shift.EndDate
 	shiftDB.DriverID = shift.DriverID
-	if err := r.data.db.Create(&shiftDB).Error; err != nil {
+	shiftDB.StartBattery = shift.StartBattery
+	shiftDB.BusID = shift.BusID
+	shiftDB.RouteID = shift.RouteID
+	if err := r.data.DB(ctx).Create(&shiftDB).Error; err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_shifts_open_driver" {
+			return biz.ErrDriverInDrive
+		}
 		return err
 	}
+	shift.Id = shiftDB.Id
 	return nil
 }
 
 // GetById implements biz.ShiftRepo.
 func (r *shiftRepo) GetByDriverID(ctx context.Context, driverId string) (*biz.Shift, error) {
 	var shiftDB Shift
-	if err := r.data.db.Where(&Shift{DriverID: driverId, EndDate: nil}).Order("start_time DESC").First(&shiftDB).Error; err != nil {
+	if err := r.data.DB(ctx).Where(&Shift{DriverID: driverId}).Where("end_date IS NULL").Order("start_time DESC").First(&shiftDB).Error; err != nil {
 		return nil, err
 	}
 	return shiftDB.modelToResponse(), nil
@@ -58,8 +90,63 @@ func (r *shiftRepo) Update(ctx context.Context, shift *biz.Shift) error {
 	shiftDB.EndDate = shift.EndDate
 	shiftDB.Id = shift.Id
 	shiftDB.DriverID = shift.DriverID
-	if err := r.data.db.Save(&shiftDB).Error; err != nil {
+	shiftDB.StartBattery = shift.StartBattery
+	shiftDB.BusID = shift.BusID
+	shiftDB.RouteID = shift.RouteID
+	shiftDB.Overrun = shift.Overrun
+	if err := r.data.DB(ctx).Omit("Bus", "Route").Save(&shiftDB).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+// List implements biz.ShiftRepo.
+func (r *shiftRepo) List(ctx context.Context, driverID string, from, to *time.Time) ([]*biz.Shift, error) {
+	var shiftsDB []Shift
+	localDB := r.data.DB(ctx).Preload("Bus").Preload("Route").Where(&Shift{DriverID: driverID})
+	if from != nil {
+		localDB = localDB.Where("end_date IS NULL OR end_date > ?", *from)
+	}
+	if to != nil {
+		localDB = localDB.Where("start_time < ?", *to)
+	}
+	if err := localDB.Order("start_time").Find(&shiftsDB).Error; err != nil {
+		return nil, err
+	}
+	shifts := make([]*biz.Shift, 0, len(shiftsDB))
+	for _, s := range shiftsDB {
+		shifts = append(shifts, s.modelToResponse())
+	}
+	return shifts, nil
+}
+
+// ListOverrun implements biz.ShiftRepo.
+func (r *shiftRepo) ListOverrun(ctx context.Context, startedBefore time.Time) ([]*biz.Shift, error) {
+	var shiftsDB []Shift
+	err := r.data.DB(ctx).Where("end_date IS NULL AND overrun = ? AND start_time < ?", false, startedBefore).Find(&shiftsDB).Error
+	if err != nil {
+		return nil, err
+	}
+	shifts := make([]*biz.Shift, 0, len(shiftsDB))
+	for _, s := range shiftsDB {
+		shifts = append(shifts, s.modelToResponse())
+	}
+	return shifts, nil
+}
+
+// MarkOverrun implements biz.ShiftRepo.
+func (r *shiftRepo) MarkOverrun(ctx context.Context, id uint32) error {
+	return r.data.DB(ctx).Model(&Shift{Id: id}).Update("overrun", true).Error
+}
+
+func NewRegulation(c *conf.Data) *biz.Regulation {
+	rules := c.GetRegulation()
+	return &biz.Regulation{
+		MaxShift:  rules.GetMaxShift().AsDuration(),
+		MinRest:   rules.GetMinRest().AsDuration(),
+		DailyCap:  rules.GetDailyCap().AsDuration(),
+		WeeklyCap: rules.GetWeeklyCap().AsDuration(),
+		MaxBreak:  rules.GetMaxBreak().AsDuration(),
+		AutoClose: rules.GetAutoClose(),
+	}
+}
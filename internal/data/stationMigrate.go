@@ -0,0 +1,82 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// migrateStations готовит старую схему к AutoMigrate: связь маршрута и остановки
+// получает ключ (route_id, position), а дубли остановок сливаются в одну запись,
+// иначе уникальный индекс idx_stations_place не создается
+func migrateStations(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&RouteStations{}) || !m.HasTable(&Stations{}) {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := migrateRouteStationsKey(tx); err != nil {
+			return err
+		}
+		return mergeDuplicateStations(tx)
+	})
+}
+
+// migrateRouteStationsKey переводит первичный ключ route_stations с
+// (route_id, stations_id) на (route_id, position)
+func migrateRouteStationsKey(tx *gorm.DB) error {
+	m := tx.Migrator()
+	if !m.HasColumn(&RouteStations{}, "Position") {
+		if err := tx.Exec(`ALTER TABLE route_stations ADD COLUMN position bigint NOT NULL DEFAULT 0`).Error; err != nil {
+			return err
+		}
+		// порядок в старой схеме не хранился, нумеруем по id остановки
+		err := tx.Exec(`UPDATE route_stations rs SET position = n.position
+			FROM (SELECT route_id, stations_id,
+				ROW_NUMBER() OVER (PARTITION BY route_id ORDER BY stations_id) - 1 AS position
+				FROM route_stations) n
+			WHERE rs.route_id = n.route_id AND rs.stations_id = n.stations_id`).Error
+		if err != nil {
+			return err
+		}
+	}
+	var pkey struct{ Name string }
+	err := tx.Raw(`SELECT tc.constraint_name AS name
+		FROM information_schema.table_constraints tc
+		JOIN information_schema.key_column_usage kcu
+			ON kcu.constraint_name = tc.constraint_name AND kcu.table_name = tc.table_name
+		WHERE tc.table_name = 'route_stations' AND tc.constraint_type = 'PRIMARY KEY'
+			AND kcu.column_name = 'stations_id'`).Scan(&pkey).Error
+	if err != nil {
+		return err
+	}
+	if pkey.Name == "" {
+		return nil
+	}
+	log.Infof("migrating route_stations primary key %s to (route_id, position)", pkey.Name)
+	return tx.Exec(`ALTER TABLE route_stations DROP CONSTRAINT "` + pkey.Name + `", ADD PRIMARY KEY (route_id, position)`).Error
+}
+
+// mergeDuplicateStations оставляет остановку с меньшим id среди совпадающих по
+// названию и координатам и переносит на нее ссылки маршрутов
+func mergeDuplicateStations(tx *gorm.DB) error {
+	if tx.Migrator().HasIndex(&Stations{}, "idx_stations_place") {
+		return nil
+	}
+	const duplicates = `SELECT id, MIN(id) OVER (PARTITION BY name, lat, lon) AS keep FROM stations`
+	relinked := tx.Exec(`UPDATE route_stations rs SET stations_id = d.keep
+		FROM (` + duplicates + `) d
+		WHERE rs.stations_id = d.id AND d.id <> d.keep`)
+	if relinked.Error != nil {
+		return relinked.Error
+	}
+	deleted := tx.Exec(`DELETE FROM stations s
+		USING (` + duplicates + `) d
+		WHERE s.id = d.id AND d.id <> d.keep`)
+	if deleted.Error != nil {
+		return deleted.Error
+	}
+	if deleted.RowsAffected > 0 {
+		log.Infof("merged %d duplicate stations, %d route links moved", deleted.RowsAffected, relinked.RowsAffected)
+	}
+	return nil
+}
@@ -5,19 +5,35 @@ import (
 	"context"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 type Stations struct {
-	ID     uint `gorm:"primaryKey"`
-	Name   string
-	Lat    float64
-	Lon    float64
+	ID     uint    `gorm:"primaryKey"`
+	Name   string  `gorm:"uniqueIndex:idx_stations_place"`
+	Lat    float64 `gorm:"uniqueIndex:idx_stations_place"`
+	Lon    float64 `gorm:"uniqueIndex:idx_stations_place"`
+	GtfsID *string `gorm:"uniqueIndex"`
 	Routes []Route `gorm:"many2many:route_stations;"`
 }
 
+// RouteStations остановка маршрута по порядковому номеру. Одна остановка может
+// встречаться на маршруте несколько раз, например на кольцевом
+type RouteStations struct {
+	RouteID    uint32 `gorm:"primaryKey"`
+	Position   int    `gorm:"primaryKey"`
+	StationsID uint   `gorm:"not null;index"`
+}
+
 func (m Stations) modelToResponse() *biz.Stations {
 	routes := make([]biz.Route, 0)
+	// остановка, повторяющаяся на маршруте, дает маршрут несколько раз
+	seen := map[uint32]bool{}
 	for _, route := range m.Routes {
+		if seen[route.Id] {
+			continue
+		}
+		seen[route.Id] = true
 		routes = append(routes, *route.modelToResponseWithoutStations())
 	}
 	return &biz.Stations{
@@ -25,17 +41,97 @@ func (m Stations) modelToResponse() *biz.Stations {
 		Name:   m.Name,
 		Lat:    m.Lat,
 		Lon:    m.Lon,
+		GtfsID: m.GtfsID,
 		Routes: routes,
 	}
 }
 
 func (m Stations) modelToResponseWithoutRoute() *biz.Stations {
 	return &biz.Stations{
-		ID:   m.ID,
-		Name: m.Name,
-		Lat:  m.Lat,
-		Lon:  m.Lon,
+		ID:     m.ID,
+		Name:   m.Name,
+		Lat:    m.Lat,
+		Lon:    m.Lon,
+		GtfsID: m.GtfsID,
+	}
+}
+
+// findOrCreateStations возвращает записи остановок в исходном порядке.
+// Остановка с ID берется из базы, остальные ищутся по названию и координатам,
+// чтобы маршруты с общей остановкой ссылались на одну запись.
+func findOrCreateStations(db *gorm.DB, stations []biz.Stations) ([]Stations, error) {
+	result := make([]Stations, 0, len(stations))
+	for _, station := range stations {
+		var stationDB Stations
+		if station.ID != 0 {
+			if err := db.First(&stationDB, station.ID).Error; err != nil {
+				return nil, err
+			}
+		} else {
+			err := db.Where(&Stations{Name: station.Name, Lat: station.Lat, Lon: station.Lon}).
+				Attrs(&Stations{GtfsID: station.GtfsID}).
+				FirstOrCreate(&stationDB).Error
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, stationDB)
+	}
+	return result, nil
+}
+
+// replaceRouteStations перезаписывает остановки маршрута в route_stations
+func replaceRouteStations(db *gorm.DB, routeID uint32, stations []Stations) error {
+	if err := db.Where(&RouteStations{RouteID: routeID}).Delete(&RouteStations{}).Error; err != nil {
+		return err
+	}
+	links := make([]RouteStations, 0, len(stations))
+	for i, station := range stations {
+		links = append(links, RouteStations{RouteID: routeID, StationsID: station.ID, Position: i})
+	}
+	if len(links) == 0 {
+		return nil
+	}
+	return db.Create(&links).Error
+}
+
+// loadRouteStations загружает остановки маршрутов в порядке следования
+func loadRouteStations(db *gorm.DB, routes []Route) error {
+	if len(routes) == 0 {
+		return nil
 	}
+	ids := make([]uint32, 0, len(routes))
+	for _, route := range routes {
+		ids = append(ids, route.Id)
+	}
+	var links []RouteStations
+	if err := db.Where("route_id IN ?", ids).Order("route_id, position").Find(&links).Error; err != nil {
+		return err
+	}
+	stationIDs := make([]uint, 0, len(links))
+	for _, link := range links {
+		stationIDs = append(stationIDs, link.StationsID)
+	}
+	stations := map[uint]Stations{}
+	if len(stationIDs) > 0 {
+		var stationsDB []Stations
+		if err := db.Where("id IN ?", stationIDs).Find(&stationsDB).Error; err != nil {
+			return err
+		}
+		for _, station := range stationsDB {
+			stations[station.ID] = station
+		}
+	}
+	byRoute := map[uint32][]Stations{}
+	for _, link := range links {
+		if station, ok := stations[link.StationsID]; ok {
+			byRoute[link.RouteID] = append(byRoute[link.RouteID], station)
+		}
+	}
+	for i := range routes {
+		routes[i].Stations = byRoute[routes[i].Id]
+	}
+	return nil
 }
 
 type stationsRepo struct {
@@ -48,26 +144,130 @@ func NewStationsRepo(data *Data, logger log.Logger) biz.StationRepo {
 }
 
 // Create implements biz.StationRepo.
-// func (r *stationsRepo) Create(ctx context.Context, station *biz.Stations) error {
-// 	stationDB :=
-// }
+func (r *stationsRepo) Create(ctx context.Context, station *biz.Stations) error {
+	stations, err := findOrCreateStations(r.data.DB(ctx), []biz.Stations{{
+		Name:   station.Name,
+		Lat:    station.Lat,
+		Lon:    station.Lon,
+		GtfsID: station.GtfsID,
+	}})
+	if err != nil {
+		return err
+	}
+	station.ID = stations[0].ID
+	return nil
+}
 
 // Delete implements biz.StationRepo.
-func (*stationsRepo) Delete(context.Context, uint32) error {
-	panic("unimplemented")
+func (r *stationsRepo) Delete(ctx context.Context, id uint32) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Where(&RouteStations{StationsID: uint(id)}).Delete(&RouteStations{}).Error; err != nil {
+			return err
+		}
+		return db.Delete(&Stations{}, id).Error
+	})
 }
 
 // GetById implements biz.StationRepo.
-func (*stationsRepo) GetById(context.Context, uint32) (biz.Stations, error) {
-	panic("unimplemented")
+func (r *stationsRepo) GetById(ctx context.Context, id uint32) (biz.Stations, error) {
+	var stationDB Stations
+	if err := r.data.DB(ctx).Preload("Routes").First(&stationDB, id).Error; err != nil {
+		return biz.Stations{}, err
+	}
+	return *stationDB.modelToResponse(), nil
 }
 
 // List implements biz.StationRepo.
-func (*stationsRepo) List(context.Context) ([]*biz.Stations, int64, error) {
-	panic("unimplemented")
+func (r *stationsRepo) List(ctx context.Context) ([]*biz.Stations, int64, error) {
+	var stationDB []Stations
+	localDB := r.data.DB(ctx).Model(&Stations{})
+	if err := localDB.Preload("Routes").Order("id").Find(&stationDB).Error; err != nil {
+		return nil, 0, err
+	}
+	var count int64
+	localDB.Count(&count)
+	stations := make([]*biz.Stations, 0)
+	for _, s := range stationDB {
+		stations = append(stations, s.modelToResponse())
+	}
+	return stations, count, nil
 }
 
 // Update implements biz.StationRepo.
-func (*stationsRepo) Update(context.Context, *biz.StationsPatch) error {
-	panic("unimplemented")
+func (r *stationsRepo) Update(ctx context.Context, patch *biz.StationsPatch) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		var stationDB Stations
+		if err := db.First(&stationDB, patch.ID).Error; err != nil {
+			return err
+		}
+		updates := map[string]interface{}{}
+		if patch.Name != nil {
+			updates["name"] = *patch.Name
+		}
+		if patch.Lat != nil {
+			updates["lat"] = *patch.Lat
+		}
+		if patch.Lon != nil {
+			updates["lon"] = *patch.Lon
+		}
+		if patch.GtfsID != nil {
+			updates["gtfs_id"] = *patch.GtfsID
+		}
+		if len(updates) > 0 {
+			if err := db.Model(&stationDB).Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+		if patch.Routes == nil {
+			return nil
+		}
+		return r.replaceStationRoutes(db, patch.ID, *patch.Routes)
+	})
+}
+
+// replaceStationRoutes оставляет остановку только на переданных маршрутах.
+// На новые маршруты остановка добавляется последней.
+func (r *stationsRepo) replaceStationRoutes(db *gorm.DB, stationID uint, routes []*biz.Route) error {
+	var links []RouteStations
+	if err := db.Where(&RouteStations{StationsID: stationID}).Find(&links).Error; err != nil {
+		return err
+	}
+	keep := map[uint32]bool{}
+	for _, route := range routes {
+		keep[route.Id] = true
+	}
+	present := map[uint32]bool{}
+	for _, link := range links {
+		if keep[link.RouteID] {
+			present[link.RouteID] = true
+			continue
+		}
+		if err := db.Where(&RouteStations{RouteID: link.RouteID, Position: link.Position}).Delete(&RouteStations{}).Error; err != nil {
+			return err
+		}
+	}
+	for routeID := range keep {
+		if present[routeID] {
+			continue
+		}
+		if err := db.First(&Route{}, routeID).Error; err != nil {
+			return err
+		}
+		var last struct{ Position *int }
+		if err := db.Model(&RouteStations{}).Select("MAX(position) AS position").
+			Where(&RouteStations{RouteID: routeID}).Scan(&last).Error; err != nil {
+			return err
+		}
+		position := 0
+		if last.Position != nil {
+			position = *last.Position + 1
+		}
+		link := RouteStations{RouteID: routeID, StationsID: stationID, Position: position}
+		if err := db.Create(&link).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,137 @@
+package data
+
+import (
+	"bus-service/internal/biz"
+	"context"
+
+	pq "github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+type Timetable struct {
+	Id             uint32 `gorm:"primaryKey"`
+	RouteID        uint32 `gorm:"index"`
+	Route          *Route `gorm:"constraint:OnDelete:CASCADE"`
+	Name           string
+	ServiceDays    pq.Int32Array `gorm:"type:integer[]"`
+	FirstDeparture *int32
+	LastDeparture  *int32
+	Headway        uint32
+	Trips          pq.Int32Array `gorm:"type:integer[]"`
+	Dwell          uint32
+}
+
+func (m Timetable) modelToResponse() *biz.Timetable {
+	timetable := &biz.Timetable{
+		Id:          m.Id,
+		RouteID:     m.RouteID,
+		Name:        m.Name,
+		ServiceDays: make([]int, 0, len(m.ServiceDays)),
+		Headway:     m.Headway,
+		Dwell:       m.Dwell,
+	}
+	for _, day := range m.ServiceDays {
+		timetable.ServiceDays = append(timetable.ServiceDays, int(day))
+	}
+	if m.FirstDeparture != nil {
+		first := biz.ClockTime(*m.FirstDeparture)
+		timetable.FirstDeparture = &first
+	}
+	if m.LastDeparture != nil {
+		last := biz.ClockTime(*m.LastDeparture)
+		timetable.LastDeparture = &last
+	}
+	for _, trip := range m.Trips {
+		timetable.Trips = append(timetable.Trips, biz.ClockTime(trip))
+	}
+	return timetable
+}
+
+func timetableToModel(timetable *biz.Timetable) Timetable {
+	timetableDB := Timetable{
+		Id:          timetable.Id,
+		RouteID:     timetable.RouteID,
+		Name:        timetable.Name,
+		ServiceDays: make(pq.Int32Array, 0, len(timetable.ServiceDays)),
+		Headway:     timetable.Headway,
+		Trips:       make(pq.Int32Array, 0, len(timetable.Trips)),
+		Dwell:       timetable.Dwell,
+	}
+	for _, day := range timetable.ServiceDays {
+		timetableDB.ServiceDays = append(timetableDB.ServiceDays, int32(day))
+	}
+	if timetable.FirstDeparture != nil {
+		first := int32(*timetable.FirstDeparture)
+		timetableDB.FirstDeparture = &first
+	}
+	if timetable.LastDeparture != nil {
+		last := int32(*timetable.LastDeparture)
+		timetableDB.LastDeparture = &last
+	}
+	for _, trip := range timetable.Trips {
+		timetableDB.Trips = append(timetableDB.Trips, int32(trip))
+	}
+	return timetableDB
+}
+
+type timetableRepo struct {
+	data *Data
+}
+
+func NewTimetableRepo(data *Data) biz.TimetableRepo {
+	return &timetableRepo{data: data}
+}
+
+// Create implements biz.TimetableRepo.
+func (r *timetableRepo) Create(ctx context.Context, timetable *biz.Timetable) error {
+	timetableDB := timetableToModel(timetable)
+	if err := r.data.DB(ctx).Create(&timetableDB).Error; err != nil {
+		return err
+	}
+	timetable.Id = timetableDB.Id
+	return nil
+}
+
+// Update implements biz.TimetableRepo.
+func (r *timetableRepo) Update(ctx context.Context, timetable *biz.Timetable) error {
+	timetableDB := timetableToModel(timetable)
+	return r.data.DB(ctx).Omit("Route").Save(&timetableDB).Error
+}
+
+// Delete implements biz.TimetableRepo.
+func (r *timetableRepo) Delete(ctx context.Context, id uint32) error {
+	result := r.data.DB(ctx).Delete(&Timetable{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetById implements biz.TimetableRepo.
+func (r *timetableRepo) GetById(ctx context.Context, id uint32) (*biz.Timetable, error) {
+	var timetableDB Timetable
+	if err := r.data.DB(ctx).Where(&Timetable{Id: id}).First(&timetableDB).Error; err != nil {
+		return nil, err
+	}
+	return timetableDB.modelToResponse(), nil
+}
+
+// List implements biz.TimetableRepo.
+func (r *timetableRepo) List(ctx context.Context, routeIDs []uint32) ([]*biz.Timetable, error) {
+	var timetablesDB []Timetable
+	localDB := r.data.DB(ctx)
+	if len(routeIDs) > 0 {
+		localDB = localDB.Where("route_id IN ?", routeIDs)
+	}
+	if err := localDB.Order("route_id, id").Find(&timetablesDB).Error; err != nil {
+		return nil, err
+	}
+	timetables := make([]*biz.Timetable, 0, len(timetablesDB))
+	for _, t := range timetablesDB {
+		timetables = append(timetables, t.modelToResponse())
+	}
+	return timetables, nil
+}
@@ -0,0 +1,222 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AccidentRouter struct {
+	uc *biz.AccidentUseCase
+	v  *validator.Validate
+}
+
+func NewAccidentRouter(uc *biz.AccidentUseCase) *AccidentRouter {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return &AccidentRouter{uc: uc, v: validate}
+}
+
+func (r *AccidentRouter) Register(router *gin.RouterGroup) {
+	router.GET("/", r.list)
+	router.GET("/:id", r.getById)
+}
+
+// RegisterAdmin маршруты, доступные только диспетчеру
+func (r *AccidentRouter) RegisterAdmin(router *gin.RouterGroup) {
+	router.POST("/", r.create)
+	router.PATCH("/:id", r.update)
+}
+
+type AccidentDTO struct {
+	Name      string   `validate:"required"`
+	Lat       *float64 `validate:"required"`
+	Lon       *float64 `validate:"required"`
+	StartDate *time.Time
+}
+
+// AccidentPatchDTO закрытие ДТП выполняется установкой EndDate
+type AccidentPatchDTO struct {
+	Name    *string
+	Lat     *float64
+	Lon     *float64
+	EndDate *time.Time
+}
+
+type ListAccidents struct {
+	Accidents []*biz.Accident `json:"accidents"`
+}
+
+// @Summary	Create accident
+// @Accept		json
+// @Produce	json
+// @Tags		accidents
+// @Param		dto	body	route.AccidentDTO	true	"dto"
+// @Success	200	{object}	biz.Accident
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/accidents/ [post]
+func (r *AccidentRouter) create(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := AccidentDTO{}
+
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	err = r.v.Struct(dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	accident := &biz.Accident{
+		Name: dto.Name,
+		Lat:  *dto.Lat,
+		Lon:  *dto.Lon,
+	}
+	if dto.StartDate != nil {
+		accident.StartDate = *dto.StartDate
+	}
+	err = r.uc.Create(context.TODO(), accident)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, accident)
+}
+
+// @Summary	Update or close accident
+// @Accept		json
+// @Produce	json
+// @Tags		accidents
+// @Param		id	path	int	true	"Accident ID"	Format(uint64)
+// @Param		dto	body	route.AccidentPatchDTO	true	"dto"
+// @Success	200	{object}	biz.Accident
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/accidents/{id} [patch]
+func (r *AccidentRouter) update(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.ParseUint(id, 10, 64)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := AccidentPatchDTO{}
+
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	accident, err := r.uc.Update(context.TODO(), &biz.AccidentPatch{
+		Id:      idUint,
+		Name:    dto.Name,
+		Lat:     dto.Lat,
+		Lon:     dto.Lon,
+		EndDate: dto.EndDate,
+	})
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, accident)
+}
+
+// @Summary	Get accident
+// @Accept		json
+// @Produce	json
+// @Tags		accidents
+// @Param		id	path	int	true	"Accident ID"	Format(uint64)
+// @Success	200	{object}	biz.Accident
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/accidents/{id} [get]
+func (r *AccidentRouter) getById(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.ParseUint(id, 10, 64)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	accident, err := r.uc.GetById(context.TODO(), idUint)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, accident)
+}
+
+// @Summary	List accidents
+// @Accept		json
+// @Produce	json
+// @Tags		accidents
+// @Param		active	query	bool	false	"только незакрытые"
+// @Success	200	{object}	route.ListAccidents
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/accidents/ [get]
+func (r *AccidentRouter) list(c *gin.Context) {
+	active := c.Query("active") == "true" || c.Query("active") == "1"
+	accidents, err := r.uc.List(context.TODO(), active)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListAccidents{
+		Accidents: accidents,
+	})
+}
@@ -3,4 +3,4 @@ package route
 import "github.com/google/wire"
 
 // ProviderSet is riute providers.
-var ProviderSet = wire.NewSet(NewBusRouter, NewRouteRouter, NewDriverRoute)
+var ProviderSet = wire.NewSet(NewBusRouter, NewRouteRouter, NewDriverRoute, NewStationRouter, NewPositionRouter, NewStreamRouter, NewBatteryRouter, NewRosterRouter, NewTimetableRouter, NewGtfsRouter, NewAccidentRouter, NewHealthRouter, NewDeadLetterRouter)
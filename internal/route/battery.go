@@ -0,0 +1,159 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type BatteryRouter struct {
+	uc *biz.BatteryUseCase
+	v  *validator.Validate
+}
+
+func NewBatteryRouter(uc *biz.BatteryUseCase) *BatteryRouter {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return &BatteryRouter{uc: uc, v: validate}
+}
+
+// Register добавляет маршруты в группу /bus
+func (r *BatteryRouter) Register(router *gin.RouterGroup) {
+	router.POST("/:id/battery", r.report)
+	router.GET("/:id/battery/history", r.history)
+}
+
+type BatteryDTO struct {
+	Level *uint `validate:"required,max=100"`
+}
+
+// @Summary	Передать уровень заряда батареи
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Param		id	path	int	true	"Bus ID"	Format(uint64)
+// @Param		dto	body	route.BatteryDTO	true	"dto"
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/bus/{id}/battery [post]
+func (r *BatteryRouter) report(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := BatteryDTO{}
+
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	err = r.v.Struct(dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	err = r.uc.SetLevel(context.TODO(), uint32(idUint), *dto.Level)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(200)
+}
+
+type ListBatteryHistory struct {
+	History []*biz.BatteryHistory `json:"history"`
+}
+
+// @Summary	История расхода батареи
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Param		id		path	int		true	"Bus ID"	Format(uint64)
+// @Param		from	query	string	false	"RFC3339"
+// @Param		to		query	string	false	"RFC3339"
+// @Success	200	{object}	route.ListBatteryHistory
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/bus/{id}/battery/history [get]
+func (r *BatteryRouter) history(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	from, to, err := parsePeriod(c)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	history, err := r.uc.History(context.TODO(), uint32(idUint), from, to)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListBatteryHistory{
+		History: history,
+	})
+}
+
+// parsePeriod читает необязательные параметры from и to в формате RFC3339
+func parsePeriod(c *gin.Context) (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+	if value := c.Query("from"); value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		from = &t
+	}
+	if value := c.Query("to"); value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		to = &t
+	}
+	return from, to, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bus-service/internal/biz"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"strconv"
 	"time"
@@ -11,20 +12,25 @@ import (
 	"github.com/Nerzal/gocloak/v13"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
 )
 
 type BusRouter struct {
 	uc  *biz.BusUseCase
 	v   *validator.Validate
 	ucS *biz.ShiftUseCase
+	ucE *biz.EnergyUseCase
+	ucR *biz.RosterUseCase
 }
 
-func NewBusRouter(uc *biz.BusUseCase, ucS *biz.ShiftUseCase) *BusRouter {
+func NewBusRouter(uc *biz.BusUseCase, ucS *biz.ShiftUseCase, ucE *biz.EnergyUseCase, ucR *biz.RosterUseCase) *BusRouter {
 	validate := validator.New(validator.WithRequiredStructEnabled())
 	return &BusRouter{
 		uc:  uc,
 		v:   validate,
 		ucS: ucS,
+		ucE: ucE,
+		ucR: ucR,
 	}
 }
 
@@ -37,13 +43,18 @@ func (r *BusRouter) Register(router *gin.RouterGroup) {
 	router.POST("/:id/start", r.start)
 	router.POST("/:id/charge", r.charge)
 	router.POST("/:id/stop", r.stop)
+	router.GET("/:id/status/history", r.statusHistory)
+}
+
+// RegisterAdmin маршруты, доступные только диспетчеру
+func (r *BusRouter) RegisterAdmin(router *gin.RouterGroup) {
+	router.POST("/:id/release", r.release)
+	router.PUT("/:id/status", r.setStatus)
 }
 
 type BusDTO struct {
-	RouteID  *uint32 `validate:"required"`
-	DriverID *string
-	Number   string `validate:"required"`
-	Status   string `validate:"required"`
+	RouteID *uint32 `validate:"required"`
+	Number  string  `validate:"required"`
 }
 
 // @Summary	Create bus
@@ -84,10 +95,8 @@ func (r *BusRouter) create(c *gin.Context) {
 		return
 	}
 	err = r.uc.Create(context.TODO(), &biz.BusDTO{
-		RouteID:  dto.RouteID,
-		DriverID: dto.DriverID,
-		Status:   "Не запущен",
-		Number:   dto.Number,
+		RouteID: dto.RouteID,
+		Number:  dto.Number,
 	})
 
 	if err != nil {
@@ -149,11 +158,9 @@ func (r *BusRouter) update(c *gin.Context) {
 		return
 	}
 	err = r.uc.Update(context.TODO(), &biz.BusDTO{
-		RouteID:  dto.RouteID,
-		DriverID: dto.DriverID,
-		Status:   "Не запущен",
-		Number:   dto.Number,
-		Id:       uint32(idUint),
+		RouteID: dto.RouteID,
+		Number:  dto.Number,
+		Id:      uint32(idUint),
 	})
 
 	if err != nil {
@@ -261,17 +268,22 @@ func (r *BusRouter) list(c *gin.Context) {
 	})
 }
 
+type StartShiftResponse struct {
+	Warnings []string `json:"warnings,omitempty"`
+}
+
 // @Summary	Водитель начинает смену
 // @Accept		json
 // @Produce	json
 // @Tags		bus
-// @Success	200
+// @Success	200	{object}	route.StartShiftResponse
 // @Failure	401
 // @Failure	403
 // @Failure	500
 // @Failure	400
 // @Failure	404
-// @Router		/bus/{id}start [post]
+// @Failure	409
+// @Router		/bus/{id}/start [post]
 func (r *BusRouter) start(c *gin.Context) {
 	id := c.Param("id")
 	idUint, err := strconv.Atoi(id)
@@ -290,37 +302,28 @@ func (r *BusRouter) start(c *gin.Context) {
 	if !ok {
 		return
 	}
-	err = r.ucS.Create(context.TODO(), &biz.Shift{
-		StartTime: time.Now(),
-		DriverID:  *user.Sub,
-	})
+	response := &StartShiftResponse{}
+	warning, err := r.ucR.Check(context.TODO(), *user.Sub, uint32(idUint), time.Now())
 	if err != nil {
 		c.AbortWithStatusJSON(400, &gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
-	bus, err := r.uc.GetById(context.TODO(), uint32(idUint))
-	if err != nil {
-		c.AbortWithStatusJSON(400, &gin.H{
-			"error": err.Error(),
-		})
-		return
+	if warning != "" {
+		response.Warnings = append(response.Warnings, warning)
 	}
-	err = r.uc.Update(context.TODO(), &biz.BusDTO{
-		Id:       bus.Id,
-		RouteID:  bus.RouteID,
-		Number:   bus.Number,
-		Status:   "В работе",
-		DriverID: user.Sub,
-	})
+	bus, err := r.ucS.Start(context.TODO(), uint32(idUint), *user.Sub)
 	if err != nil {
-		c.AbortWithStatusJSON(400, &gin.H{
+		c.AbortWithStatusJSON(busErrorStatus(err), &gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
-	c.Status(200)
+	if warning := r.ucE.CheckBus(context.TODO(), bus); warning != "" {
+		response.Warnings = append(response.Warnings, warning)
+	}
+	c.JSON(200, response)
 }
 
 // @Summary	Водитель заканчивает смену
@@ -333,6 +336,7 @@ func (r *BusRouter) start(c *gin.Context) {
 // @Failure	500
 // @Failure	400
 // @Failure	404
+// @Failure	409
 // @Router		/bus/{id}/stop [post]
 func (r *BusRouter) stop(c *gin.Context) {
 	id := c.Param("id")
@@ -352,42 +356,92 @@ func (r *BusRouter) stop(c *gin.Context) {
 	if !ok {
 		return
 	}
-	shift, err := r.ucS.GetByDriverID(context.TODO(), *user.Sub)
+	err = r.ucS.Stop(context.TODO(), uint32(idUint), *user.Sub)
 	if err != nil {
-		c.AbortWithStatusJSON(400, &gin.H{
+		c.AbortWithStatusJSON(busErrorStatus(err), &gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
-	endTime := time.Now()
-	err = r.ucS.Update(context.TODO(), &biz.Shift{
-		Id:        shift.Id,
-		StartTime: shift.StartTime,
-		EndDate:   &endTime,
-		DriverID:  shift.DriverID,
-	})
+	c.Status(200)
+}
+
+// @Summary	Автобус на зарядке
+// @Description	Если автобус в работе, смена водителя закрывается
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Failure	409
+// @Router		/bus/{id}/charge [post]
+func (r *BusRouter) charge(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
 	if err != nil {
-		c.AbortWithStatusJSON(400, &gin.H{
-			"error": err.Error(),
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
 		})
 		return
 	}
-	bus, err := r.uc.GetById(context.TODO(), uint32(idUint))
+	userD, ok := c.Get("user")
+	if !ok {
+		return
+	}
+	user, ok := userD.(*gocloak.UserInfo)
+	if !ok {
+		return
+	}
+	err = r.ucS.Charge(context.TODO(), uint32(idUint), *user.Sub)
 	if err != nil {
-		c.AbortWithStatusJSON(400, &gin.H{
+		c.AbortWithStatusJSON(busErrorStatus(err), &gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
-	err = r.uc.Update(context.TODO(), &biz.BusDTO{
-		Id:       bus.Id,
-		RouteID:  bus.RouteID,
-		Number:   bus.Number,
-		Status:   "Не в работе",
-		DriverID: nil,
-	})
+	c.Status(200)
+}
+
+// @Summary	Диспетчер принудительно освобождает автобус
+// @Description	Закрывает открытую смену водителя, автобус в работе переводится в idle
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Param		id	path	int	true	"Bus ID"	Format(uint64)
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Failure	409
+// @Router		/bus/{id}/release [post]
+func (r *BusRouter) release(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
 	if err != nil {
-		c.AbortWithStatusJSON(400, &gin.H{
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	userD, ok := c.Get("user")
+	if !ok {
+		return
+	}
+	user, ok := userD.(*gocloak.UserInfo)
+	if !ok {
+		return
+	}
+	err = r.ucS.Release(context.TODO(), uint32(idUint), *user.Sub)
+	if err != nil {
+		c.AbortWithStatusJSON(busErrorStatus(err), &gin.H{
 			"error": err.Error(),
 		})
 		return
@@ -395,18 +449,26 @@ func (r *BusRouter) stop(c *gin.Context) {
 	c.Status(200)
 }
 
-// @Summary	Автобус на зарядке
+type BusStatusDTO struct {
+	Status string `validate:"required"`
+}
+
+// @Summary	Изменить статус автобуса
+// @Description	Статусы: idle, charging, maintenance, out_of_order. in_service выставляется только началом смены
 // @Accept		json
 // @Produce	json
 // @Tags		bus
+// @Param		id	path	int	true	"Bus ID"	Format(uint64)
+// @Param		dto	body	route.BusStatusDTO	true	"dto"
 // @Success	200
 // @Failure	401
 // @Failure	403
 // @Failure	500
 // @Failure	400
 // @Failure	404
-// @Router		/bus/{id}/charge [post]
-func (r *BusRouter) charge(c *gin.Context) {
+// @Failure	409
+// @Router		/bus/{id}/status [put]
+func (r *BusRouter) setStatus(c *gin.Context) {
 	id := c.Param("id")
 	idUint, err := strconv.Atoi(id)
 
@@ -416,25 +478,103 @@ func (r *BusRouter) charge(c *gin.Context) {
 		})
 		return
 	}
-	bus, err := r.uc.GetById(context.TODO(), uint32(idUint))
+	userD, ok := c.Get("user")
+	if !ok {
+		return
+	}
+	user, ok := userD.(*gocloak.UserInfo)
+	if !ok {
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := BusStatusDTO{}
+
+	err = json.Unmarshal(body, &dto)
 	if err != nil {
 		c.AbortWithStatusJSON(400, &gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
-	err = r.uc.Update(context.TODO(), &biz.BusDTO{
-		Id:       bus.Id,
-		RouteID:  bus.RouteID,
-		Number:   bus.Number,
-		Status:   "На зарядке",
-		DriverID: bus.Driver.Id,
-	})
+	err = r.v.Struct(dto)
 	if err != nil {
 		c.AbortWithStatusJSON(400, &gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
+	status, err := biz.ParseBusStatus(dto.Status)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	err = r.uc.SetStatus(context.TODO(), uint32(idUint), status, *user.Sub)
+	if err != nil {
+		c.AbortWithStatusJSON(busErrorStatus(err), &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
 	c.Status(200)
 }
+
+type ListBusStatusHistory struct {
+	History []*biz.BusStatusChange `json:"history"`
+}
+
+// @Summary	История статусов автобуса
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Param		id	path	int	true	"Bus ID"	Format(uint64)
+// @Success	200	{object}	route.ListBusStatusHistory
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/bus/{id}/status/history [get]
+func (r *BusRouter) statusHistory(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	history, err := r.uc.StatusHistory(context.TODO(), uint32(idUint))
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListBusStatusHistory{
+		History: history,
+	})
+}
+
+// busErrorStatus HTTP-код ошибки смены и статуса автобуса: 409 если автобус
+// занят другим водителем или переход невозможен в текущем состоянии
+func busErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, biz.ErrBusInUse), errors.Is(err, biz.ErrBusHasDriver),
+		errors.Is(err, biz.ErrBusNoDriver), errors.Is(err, biz.ErrShiftBusMismatch),
+		errors.Is(err, biz.ErrInvalidStatusTransition), errors.Is(err, biz.ErrDriverInDrive):
+		return 409
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return 404
+	}
+	return 400
+}
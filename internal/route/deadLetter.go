@@ -0,0 +1,135 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+	"bus-service/pkg/rabbit"
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeadLetterRouter struct {
+	rabbit *biz.RabbitData
+}
+
+func NewDeadLetterRouter(rabbit *biz.RabbitData) *DeadLetterRouter {
+	return &DeadLetterRouter{rabbit: rabbit}
+}
+
+type ListDeadLetters struct {
+	Queue    string               `json:"queue"`
+	Messages []*rabbit.DeadLetter `json:"messages"`
+}
+
+type ReplayResult struct {
+	Queue    string `json:"queue"`
+	Replayed int    `json:"replayed"`
+}
+
+func (r *DeadLetterRouter) Register(router *gin.RouterGroup) {
+	router.GET("", r.queues)
+	router.GET("/:queue", r.list)
+	router.POST("/:queue/replay", r.replay)
+}
+
+// @Summary	Dead-letter queues
+// @Description	Очереди, для которых есть очередь недоставленных сообщений
+// @Produce	json
+// @Tags		dead-letters
+// @Success	200	{array}	string
+// @Failure	401
+// @Failure	403
+// @Router		/dead-letters [get]
+func (r *DeadLetterRouter) queues(c *gin.Context) {
+	c.JSON(200, r.rabbit.Conn.Queues())
+}
+
+// @Summary	List dead-lettered messages
+// @Description	Сообщения остаются в очереди недоставленных
+// @Produce	json
+// @Tags		dead-letters
+// @Param		queue	path	string	true	"accident или telemetry"
+// @Param		limit	query	int		false	"по умолчанию 50"
+// @Success	200	{object}	route.ListDeadLetters
+// @Failure	401
+// @Failure	403
+// @Failure	400
+// @Failure	404
+// @Failure	503
+// @Router		/dead-letters/{queue} [get]
+func (r *DeadLetterRouter) list(c *gin.Context) {
+	limit, ok := deadLetterLimit(c)
+	if !ok {
+		return
+	}
+	queue := c.Param("queue")
+	messages, err := r.rabbit.Conn.DeadLetters(queue, limit)
+	if err != nil {
+		deadLetterError(c, err)
+		return
+	}
+	c.JSON(200, &ListDeadLetters{
+		Queue:    queue,
+		Messages: messages,
+	})
+}
+
+// @Summary	Replay dead-lettered messages
+// @Description	Возвращает сообщения в исходную очередь со сброшенным счетчиком попыток
+// @Produce	json
+// @Tags		dead-letters
+// @Param		queue	path	string	true	"accident или telemetry"
+// @Param		limit	query	int		false	"по умолчанию 50"
+// @Success	200	{object}	route.ReplayResult
+// @Failure	401
+// @Failure	403
+// @Failure	400
+// @Failure	404
+// @Failure	503
+// @Router		/dead-letters/{queue}/replay [post]
+func (r *DeadLetterRouter) replay(c *gin.Context) {
+	limit, ok := deadLetterLimit(c)
+	if !ok {
+		return
+	}
+	queue := c.Param("queue")
+	replayed, err := r.rabbit.Conn.Replay(context.TODO(), queue, limit)
+	if err != nil {
+		deadLetterError(c, err)
+		return
+	}
+	c.JSON(200, &ReplayResult{
+		Queue:    queue,
+		Replayed: replayed,
+	})
+}
+
+func deadLetterLimit(c *gin.Context) (int, bool) {
+	limit := 50
+	if value := c.Query("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			c.AbortWithStatusJSON(400, gin.H{
+				"error": "parse limit error",
+			})
+			return 0, false
+		}
+	}
+	return limit, true
+}
+
+func deadLetterError(c *gin.Context, err error) {
+	code := 500
+	switch {
+	case errors.Is(err, rabbit.ErrUnknownQueue):
+		code = 404
+	case errors.Is(err, rabbit.ErrNotConnected):
+		code = 503
+	}
+	c.AbortWithStatusJSON(code, gin.H{
+		"error": err.Error(),
+	})
+}
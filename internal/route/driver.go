@@ -3,20 +3,32 @@ package route
 import (
 	"bus-service/internal/biz"
 	"context"
+	"errors"
 
+	"github.com/Nerzal/gocloak/v13"
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 type DriverRoute struct {
-	uc *biz.DriverUseCase
+	uc  *biz.DriverUseCase
+	ucS *biz.ShiftUseCase
+	ucR *biz.RosterUseCase
 }
 
-func NewDriverRoute(uc *biz.DriverUseCase) *DriverRoute {
-	return &DriverRoute{uc: uc}
+func NewDriverRoute(uc *biz.DriverUseCase, ucS *biz.ShiftUseCase, ucR *biz.RosterUseCase) *DriverRoute {
+	return &DriverRoute{uc: uc, ucS: ucS, ucR: ucR}
 }
 
 func (r *DriverRoute) Register(router *gin.RouterGroup) {
 	router.GET("/", r.getDrivers)
+	router.GET("/me/shifts", r.myShifts)
+	router.GET("/me/next-assignment", r.nextAssignment)
+}
+
+// RegisterAdmin маршруты, доступные только диспетчеру
+func (r *DriverRoute) RegisterAdmin(router *gin.RouterGroup) {
+	router.GET("/:id/shifts", r.shifts)
 }
 
 type ListDriverDTO struct {
@@ -46,3 +58,101 @@ func (r *DriverRoute) getDrivers(c *gin.Context) {
 		Drivers: drivers,
 	})
 }
+
+// @Summary	Табель водителя
+// @Description	Смены за период с автобусом и маршрутом, часы по дням, неделям и месяцам
+// @Accept		json
+// @Produce	json
+// @Tags		drivers
+// @Param		id		path	string	true	"Driver ID"
+// @Param		from	query	string	false	"RFC3339"
+// @Param		to		query	string	false	"RFC3339"
+// @Success	200	{object}	biz.Timesheet
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/drivers/{id}/shifts [get]
+func (r *DriverRoute) shifts(c *gin.Context) {
+	r.timesheet(c, c.Param("id"))
+}
+
+// @Summary	Табель текущего водителя
+// @Accept		json
+// @Produce	json
+// @Tags		drivers
+// @Param		from	query	string	false	"RFC3339"
+// @Param		to		query	string	false	"RFC3339"
+// @Success	200	{object}	biz.Timesheet
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/drivers/me/shifts [get]
+func (r *DriverRoute) myShifts(c *gin.Context) {
+	userD, ok := c.Get("user")
+	if !ok {
+		return
+	}
+	user, ok := userD.(*gocloak.UserInfo)
+	if !ok {
+		return
+	}
+	r.timesheet(c, *user.Sub)
+}
+
+func (r *DriverRoute) timesheet(c *gin.Context, driverID string) {
+	from, to, err := parsePeriod(c)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	sheet, err := r.ucS.Timesheet(context.TODO(), driverID, from, to)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, sheet)
+}
+
+// @Summary	Ближайшее назначение текущего водителя
+// @Accept		json
+// @Produce	json
+// @Tags		drivers
+// @Success	200	{object}	biz.Assignment
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/drivers/me/next-assignment [get]
+func (r *DriverRoute) nextAssignment(c *gin.Context) {
+	userD, ok := c.Get("user")
+	if !ok {
+		return
+	}
+	user, ok := userD.(*gocloak.UserInfo)
+	if !ok {
+		return
+	}
+	assignment, err := r.ucR.Next(context.TODO(), *user.Sub)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.AbortWithStatusJSON(404, gin.H{
+			"error": "NO_ASSIGNMENT",
+		})
+		return
+	}
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, assignment)
+}
@@ -0,0 +1,159 @@
+package route
+
+import (
+	rt "bus-service/api/gtfsrt/v1"
+	"bus-service/internal/biz"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type GtfsRouter struct {
+	uc   *biz.GtfsUseCase
+	ucRT *biz.GtfsRealtimeUseCase
+}
+
+// maxGtfsImportSize предельный размер архива GTFS для импорта
+const maxGtfsImportSize = 64 << 20
+
+func NewGtfsRouter(uc *biz.GtfsUseCase, ucRT *biz.GtfsRealtimeUseCase) *GtfsRouter {
+	return &GtfsRouter{uc: uc, ucRT: ucRT}
+}
+
+func (r *GtfsRouter) Register(router *gin.RouterGroup) {
+	router.GET("/static.zip", r.export)
+	router.GET("/realtime/vehicle-positions", r.vehiclePositions)
+	router.GET("/realtime/alerts", r.alerts)
+}
+
+// RegisterAdmin маршруты, доступные только диспетчеру
+func (r *GtfsRouter) RegisterAdmin(router *gin.RouterGroup) {
+	router.POST("/import", r.importFeed)
+}
+
+// @Summary	GTFS static feed
+// @Description	routes, stops, shapes, trips и stop_times одним zip-архивом
+// @Produce	application/zip
+// @Tags		gtfs
+// @Success	200
+// @Failure	500
+// @Router		/gtfs/static.zip [get]
+func (r *GtfsRouter) export(c *gin.Context) {
+	feed, err := r.uc.Export(context.TODO())
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	var buf bytes.Buffer
+	if err := feed.WriteZip(&buf); err != nil {
+		c.AbortWithStatusJSON(500, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="gtfs.zip"`)
+	c.Data(200, "application/zip", buf.Bytes())
+}
+
+// @Summary	Import GTFS static feed
+// @Description	Создает и обновляет маршруты и остановки из zip-архива GTFS в теле запроса
+// @Accept		application/zip
+// @Produce	json
+// @Tags		gtfs
+// @Param		dry_run	query	bool	false	"только отчет об изменениях"
+// @Success	200	{object}	biz.GtfsImportReport
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	413
+// @Router		/gtfs/import [post]
+func (r *GtfsRouter) importFeed(c *gin.Context) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxGtfsImportSize))
+	if err != nil {
+		status := 400
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = 413
+		}
+		c.AbortWithStatusJSON(status, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dryRun := c.Query("dry_run") == "true" || c.Query("dry_run") == "1"
+	report, err := r.uc.Import(context.TODO(), body, dryRun)
+	if err != nil {
+		status := 500
+		if errors.Is(err, biz.ErrInvalidGtfs) {
+			status = 400
+		}
+		c.AbortWithStatusJSON(status, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, report)
+}
+
+// @Summary	GTFS-Realtime VehiclePositions
+// @Description	Положения автобусов в работе, format=json для отладки
+// @Produce	application/x-protobuf
+// @Tags		gtfs
+// @Param		format	query	string	false	"json"
+// @Success	200
+// @Failure	500
+// @Router		/gtfs/realtime/vehicle-positions [get]
+func (r *GtfsRouter) vehiclePositions(c *gin.Context) {
+	feed, err := r.ucRT.VehiclePositions(context.TODO())
+	writeFeed(c, feed, err)
+}
+
+// @Summary	GTFS-Realtime ServiceAlerts
+// @Description	Оповещения по активным ДТП на маршрутах, format=json для отладки
+// @Produce	application/x-protobuf
+// @Tags		gtfs
+// @Param		format	query	string	false	"json"
+// @Success	200
+// @Failure	500
+// @Router		/gtfs/realtime/alerts [get]
+func (r *GtfsRouter) alerts(c *gin.Context) {
+	feed, err := r.ucRT.ServiceAlerts(context.TODO())
+	writeFeed(c, feed, err)
+}
+
+func writeFeed(c *gin.Context, feed *rt.FeedMessage, err error) {
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if c.Query("format") == "json" {
+		body, err := protojson.Marshal(feed)
+		if err != nil {
+			c.AbortWithStatusJSON(500, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		c.Data(200, "application/json", body)
+		return
+	}
+	body, err := proto.Marshal(feed)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.Data(200, "application/x-protobuf", body)
+}
@@ -0,0 +1,37 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthRouter struct {
+	rabbit *biz.RabbitData
+}
+
+func NewHealthRouter(rabbit *biz.RabbitData) *HealthRouter {
+	return &HealthRouter{rabbit: rabbit}
+}
+
+func (r *HealthRouter) Register(router *gin.RouterGroup) {
+	router.GET("", r.health)
+}
+
+// @Summary	Health check
+// @Description	Состояние подключений сервиса, 503 если RabbitMQ недоступен
+// @Produce	json
+// @Tags		health
+// @Success	200
+// @Failure	503
+// @Router		/health [get]
+func (r *HealthRouter) health(c *gin.Context) {
+	state := r.rabbit.Conn.State()
+	code := 200
+	if !state.Connected {
+		code = 503
+	}
+	c.JSON(code, gin.H{
+		"rabbit": state,
+	})
+}
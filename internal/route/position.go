@@ -0,0 +1,185 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+type PositionRouter struct {
+	uc *biz.PositionUseCase
+	v  *validator.Validate
+}
+
+func NewPositionRouter(uc *biz.PositionUseCase) *PositionRouter {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return &PositionRouter{uc: uc, v: validate}
+}
+
+// Register добавляет маршруты в группу /bus
+func (r *PositionRouter) Register(router *gin.RouterGroup) {
+	router.GET("/positions", r.list)
+	router.GET("/:id/position", r.getByBusId)
+	router.POST("/:id/position", r.report)
+}
+
+type PositionDTO struct {
+	Lat       *float64 `validate:"required"`
+	Lon       *float64 `validate:"required"`
+	Speed     float32
+	Heading   float32
+	Timestamp *time.Time
+}
+
+// @Summary	Передать GPS-отметку автобуса
+// @Description	Только водитель автобуса или диспетчер
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Param		id	path	int	true	"Bus ID"	Format(uint64)
+// @Param		dto	body	route.PositionDTO	true	"dto"
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/bus/{id}/position [post]
+func (r *PositionRouter) report(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := PositionDTO{}
+
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	err = r.v.Struct(dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	position := &biz.BusPosition{
+		BusID:   uint32(idUint),
+		Lat:     *dto.Lat,
+		Lon:     *dto.Lon,
+		Speed:   dto.Speed,
+		Heading: dto.Heading,
+	}
+	if dto.Timestamp != nil {
+		position.Timestamp = *dto.Timestamp
+	}
+	userD, ok := c.Get("user")
+	if !ok {
+		return
+	}
+	user, ok := userD.(*gocloak.UserInfo)
+	if !ok || user.Sub == nil {
+		return
+	}
+	err = r.uc.ReportFrom(context.TODO(), position, *user.Sub)
+	if err != nil {
+		status := 400
+		if errors.Is(err, biz.ErrPositionForbidden) {
+			status = 403
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = 404
+		}
+		c.AbortWithStatusJSON(status, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(200)
+}
+
+// @Summary	Последнее положение автобуса
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Param		id	path	int	true	"Bus ID"	Format(uint64)
+// @Success	200	{object}	biz.BusPosition
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/bus/{id}/position [get]
+func (r *PositionRouter) getByBusId(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	position, err := r.uc.GetByBusID(context.TODO(), uint32(idUint))
+	if err != nil {
+		c.AbortWithStatusJSON(404, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, position)
+}
+
+type ListPositions struct {
+	Positions []*biz.BusPosition `json:"positions"`
+}
+
+// @Summary	Положение всех автобусов
+// @Accept		json
+// @Produce	json
+// @Tags		bus
+// @Success	200	{object}	route.ListPositions
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/bus/positions [get]
+func (r *PositionRouter) list(c *gin.Context) {
+	positions, err := r.uc.List(context.TODO())
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListPositions{
+		Positions: positions,
+	})
+}
@@ -0,0 +1,262 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type RosterRouter struct {
+	uc *biz.RosterUseCase
+	v  *validator.Validate
+}
+
+func NewRosterRouter(uc *biz.RosterUseCase) *RosterRouter {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return &RosterRouter{uc: uc, v: validate}
+}
+
+func (r *RosterRouter) Register(router *gin.RouterGroup) {
+	router.POST("/", r.create)
+	router.GET("/", r.list)
+	router.GET("/:id", r.getById)
+	router.PUT("/:id", r.update)
+	router.DELETE("/:id", r.delete)
+}
+
+type AssignmentDTO struct {
+	DriverID     string `validate:"required"`
+	BusID        uint32 `validate:"required"`
+	RouteID      *uint32
+	PlannedStart time.Time `validate:"required"`
+	PlannedEnd   time.Time `validate:"required"`
+}
+
+type ListAssignments struct {
+	Assignments []*biz.Assignment `json:"assignments"`
+}
+
+func (r *RosterRouter) parseDTO(c *gin.Context) (*AssignmentDTO, bool) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+	dto := &AssignmentDTO{}
+	err = json.Unmarshal(body, dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+	err = r.v.Struct(dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+	return dto, true
+}
+
+// @Summary	Create roster assignment
+// @Accept		json
+// @Produce	json
+// @Tags		roster
+// @Param		dto	body	route.AssignmentDTO	true	"dto"
+// @Success	200	{object}	biz.Assignment
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/roster/ [post]
+func (r *RosterRouter) create(c *gin.Context) {
+	dto, ok := r.parseDTO(c)
+	if !ok {
+		return
+	}
+	assignment := &biz.Assignment{
+		DriverID:     dto.DriverID,
+		BusID:        dto.BusID,
+		RouteID:      dto.RouteID,
+		PlannedStart: dto.PlannedStart,
+		PlannedEnd:   dto.PlannedEnd,
+	}
+	err := r.uc.Create(context.TODO(), assignment)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, assignment)
+}
+
+// @Summary	Update roster assignment
+// @Accept		json
+// @Produce	json
+// @Tags		roster
+// @Param		id	path	int	true	"Assignment ID"	Format(uint64)
+// @Param		dto	body	route.AssignmentDTO	true	"dto"
+// @Success	200	{object}	biz.Assignment
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/roster/{id} [put]
+func (r *RosterRouter) update(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	dto, ok := r.parseDTO(c)
+	if !ok {
+		return
+	}
+	assignment := &biz.Assignment{
+		Id:           uint32(idUint),
+		DriverID:     dto.DriverID,
+		BusID:        dto.BusID,
+		RouteID:      dto.RouteID,
+		PlannedStart: dto.PlannedStart,
+		PlannedEnd:   dto.PlannedEnd,
+	}
+	err = r.uc.Update(context.TODO(), assignment)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, assignment)
+}
+
+// @Summary	Delete roster assignment
+// @Accept		json
+// @Produce	json
+// @Tags		roster
+// @Param		id	path	int	true	"Assignment ID"	Format(uint64)
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/roster/{id} [delete]
+func (r *RosterRouter) delete(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	err = r.uc.Delete(context.TODO(), uint32(idUint))
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.Status(200)
+}
+
+// @Summary	Get roster assignment
+// @Accept		json
+// @Produce	json
+// @Tags		roster
+// @Param		id	path	int	true	"Assignment ID"	Format(uint64)
+// @Success	200	{object}	biz.Assignment
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/roster/{id} [get]
+func (r *RosterRouter) getById(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	assignment, err := r.uc.GetById(context.TODO(), uint32(idUint))
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, assignment)
+}
+
+// @Summary	List roster assignments
+// @Accept		json
+// @Produce	json
+// @Tags		roster
+// @Param		driver	query	string	false	"Driver ID"
+// @Param		bus		query	int		false	"Bus ID"
+// @Param		from	query	string	false	"RFC3339"
+// @Param		to		query	string	false	"RFC3339"
+// @Success	200	{object}	route.ListAssignments
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/roster/ [get]
+func (r *RosterRouter) list(c *gin.Context) {
+	from, to, err := parsePeriod(c)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	filter := &biz.AssignmentFilter{From: from, To: to}
+	if driver := c.Query("driver"); driver != "" {
+		filter.DriverID = &driver
+	}
+	if bus := c.Query("bus"); bus != "" {
+		busID, err := strconv.Atoi(bus)
+		if err != nil {
+			c.AbortWithStatusJSON(400, gin.H{
+				"error": "parse bus error",
+			})
+			return
+		}
+		busUint := uint32(busID)
+		filter.BusID = &busUint
+	}
+	assignments, err := r.uc.List(context.TODO(), filter)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListAssignments{
+		Assignments: assignments,
+	})
+}
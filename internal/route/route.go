@@ -1,7 +1,6 @@
 package route
 
 import (
-	mapS "bus-service/api/map/v1"
 	"bus-service/internal/biz"
 	"context"
 	"encoding/json"
@@ -13,22 +12,23 @@ import (
 )
 
 type RouteRouter struct {
-	uc        *biz.RouteUseCase
-	mapClient mapS.MapClient
-	v         *validator.Validate
+	uc  *biz.RouteUseCase
+	ucE *biz.EnergyUseCase
+	v   *validator.Validate
 }
 
-func NewRouteRouter(uc *biz.RouteUseCase, mapClient mapS.MapClient) *RouteRouter {
+func NewRouteRouter(uc *biz.RouteUseCase, ucE *biz.EnergyUseCase) *RouteRouter {
 	validate := validator.New(validator.WithRequiredStructEnabled())
-	return &RouteRouter{uc: uc, mapClient: mapClient, v: validate}
+	return &RouteRouter{uc: uc, ucE: ucE, v: validate}
 }
 
 func (r *RouteRouter) Register(router *gin.RouterGroup) {
 	router.POST("/", r.create)
 	router.GET("/:id", r.getById)
-	// router.PUT("/:id", r.update)
+	router.PUT("/:id", r.update)
 	router.DELETE("/:id", r.delete)
 	router.GET("/", r.list)
+	router.GET("/:id/energy-estimate", r.energyEstimate)
 }
 
 type StationDTO struct {
@@ -89,30 +89,18 @@ func (r *RouteRouter) create(c *gin.Context) {
 			Lon:  station.Lon,
 		})
 	}
-	points := make([]*mapS.Point, 0)
-	for _, station := range stations {
-		points = append(points, &mapS.Point{
-			Lat: float32(station.Lat),
-			Lon: float32(station.Lon),
-		})
+	route := &biz.Route{
+		Number:   dto.Number,
+		Stations: stations,
 	}
-	req, err := r.mapClient.GetPath(context.TODO(), &mapS.GetPathRequest{
-		Points: points,
-	})
+	err = r.uc.Plan(context.TODO(), route)
 	if err != nil {
 		c.AbortWithStatusJSON(400, &gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
-	err = r.uc.Create(context.TODO(), &biz.Route{
-		Number:   dto.Number,
-		Path:     req.Shape,
-		Time:     req.Time,
-		Stations: stations,
-		Lengths:  req.Lengths,
-		Length:   req.Length,
-	})
+	err = r.uc.Create(context.TODO(), route)
 
 	if err != nil {
 		c.AbortWithStatusJSON(400, &gin.H{
@@ -137,82 +125,65 @@ func (r *RouteRouter) create(c *gin.Context) {
 // @Failure	400
 // @Failure	404
 // @Router		/route/{id} [put]
-// func (r *RouteRouter) update(c *gin.Context) {
-// 	id := c.Param("id")
-// 	idUint, err := strconv.Atoi(id)
-
-// 	if err != nil {
-// 		c.AbortWithStatusJSON(400, gin.H{
-// 			"error": "parse id error",
-// 		})
-// 		return
-// 	}
-
-// 	body, err := io.ReadAll(c.Request.Body)
-
-// 	if err != nil {
-// 		c.JSON(400, &gin.H{
-// 			"error": err.Error(),
-// 		})
-// 		return
-// 	}
-// 	dto := RouteDTO{}
-
-// 	err = json.Unmarshal(body, &dto)
-// 	if err != nil {
-// 		c.AbortWithStatusJSON(400, &gin.H{
-// 			"error": err.Error(),
-// 		})
-// 		return
-// 	}
-// 	err = r.v.Struct(dto)
-// 	if err != nil {
-// 		c.AbortWithStatusJSON(400, &gin.H{
-// 			"error": err.Error(),
-// 		})
-// 		return
-// 	}
-// 	stations := make([]biz.Stations, 0)
-// 	for _, station := range dto.Stations {
-// 		stations = append(stations, biz.Stations{
-// 			ID:   uint(station.ID),
-// 			Lat:  station.Lat,
-// 			Name: station.Name,
-// 			Lon:  station.Lon,
-// 		})
-// 	}
-// 	points := make([]*mapS.Point, 0)
-// 	for _, station := range stations {
-// 		points = append(points, &mapS.Point{
-// 			Lat: float32(station.Lat),
-// 			Lon: float32(station.Lon),
-// 		})
-// 	}
-// 	req, err := r.mapClient.GetPath(context.TODO(), &mapS.GetPathRequest{
-// 		Points: points,
-// 	})
-// 	if err != nil {
-// 		c.AbortWithStatusJSON(400, &gin.H{
-// 			"error": err.Error(),
-// 		})
-// 		return
-// 	}
-// 	err = r.uc.Update(context.TODO(), &biz.Route{
-// 		Id:       uint32(idUint),
-// 		Number:   dto.Number,
-// 		Path:     req.Shape,
-// 		Stations: stations,
-// 	})
-
-// 	if err != nil {
-// 		c.AbortWithStatusJSON(400, &gin.H{
-// 			"error": err.Error(),
-// 		})
-// 		return
-// 	}
-
-// 	c.Status(200)
-// }
+func (r *RouteRouter) update(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := RouteDTO{}
+
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	err = r.v.Struct(dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	stations := make([]biz.Stations, 0)
+	for _, station := range dto.Stations {
+		stations = append(stations, biz.Stations{
+			ID:   uint(station.ID),
+			Lat:  station.Lat,
+			Name: station.Name,
+			Lon:  station.Lon,
+		})
+	}
+	err = r.uc.Update(context.TODO(), &biz.Route{
+		Id:       uint32(idUint),
+		Number:   dto.Number,
+		Stations: stations,
+	})
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(200)
+}
 
 // @Summary	Delete route
 // @Accept		json
@@ -313,3 +284,48 @@ func (r *RouteRouter) list(c *gin.Context) {
 		Count:  total,
 	})
 }
+
+// @Summary	Прогноз расхода батареи на рейс
+// @Accept		json
+// @Produce	json
+// @Tags		route
+// @Param		id	path	int	true	"Route ID"	Format(uint64)
+// @Param		bus	query	int	false	"Bus ID"	Format(uint64)
+// @Success	200	{object}	biz.EnergyEstimate
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/route/{id}/energy-estimate [get]
+func (r *RouteRouter) energyEstimate(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	var busID *uint32
+	if bus := c.Query("bus"); bus != "" {
+		busUint, err := strconv.Atoi(bus)
+		if err != nil {
+			c.AbortWithStatusJSON(400, gin.H{
+				"error": "parse bus id error",
+			})
+			return
+		}
+		value := uint32(busUint)
+		busID = &value
+	}
+	estimate, err := r.ucE.Estimate(context.TODO(), uint32(idUint), busID)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, estimate)
+}
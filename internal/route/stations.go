@@ -1,5 +1,319 @@
 package route
 
-// type StationRouter struct {
-// 	uc *biz.Station
-// }
+import (
+	"bus-service/internal/biz"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type StationRouter struct {
+	uc  *biz.StationUseCase
+	ucT *biz.TimetableUseCase
+	v   *validator.Validate
+}
+
+func NewStationRouter(uc *biz.StationUseCase, ucT *biz.TimetableUseCase) *StationRouter {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return &StationRouter{uc: uc, ucT: ucT, v: validate}
+}
+
+func (r *StationRouter) Register(router *gin.RouterGroup) {
+	router.POST("/", r.create)
+	router.GET("/:id", r.getById)
+	router.PATCH("/:id", r.update)
+	router.DELETE("/:id", r.delete)
+	router.GET("/", r.list)
+	router.GET("/:id/departures", r.departures)
+}
+
+type StationPatchDTO struct {
+	Name   *string
+	Lat    *float64
+	Lon    *float64
+	Routes *[]uint32
+}
+
+// @Summary	Create station
+// @Accept		json
+// @Produce	json
+// @Tags		stations
+// @Param		dto	body	route.StationDTO	true	"dto"
+// @Success	200	{object}	biz.Stations
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/stations/ [post]
+func (r *StationRouter) create(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := StationDTO{}
+
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	err = r.v.Struct(dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	station := &biz.Stations{
+		Name: dto.Name,
+		Lat:  dto.Lat,
+		Lon:  dto.Lon,
+	}
+	err = r.uc.Create(context.TODO(), station)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, station)
+}
+
+// @Summary	Update station
+// @Accept		json
+// @Produce	json
+// @Tags		stations
+// @Param		id	path	int	true	"Station ID"	Format(uint64)
+// @Param		dto	body	route.StationPatchDTO	true	"dto"
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/stations/{id} [patch]
+func (r *StationRouter) update(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+
+	if err != nil {
+		c.JSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	dto := StationPatchDTO{}
+
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	patch := &biz.StationsPatch{
+		ID:   uint(idUint),
+		Name: dto.Name,
+		Lat:  dto.Lat,
+		Lon:  dto.Lon,
+	}
+	if dto.Routes != nil {
+		routes := make([]*biz.Route, 0)
+		for _, routeID := range *dto.Routes {
+			routes = append(routes, &biz.Route{Id: routeID})
+		}
+		patch.Routes = &routes
+	}
+	err = r.uc.Update(context.TODO(), patch)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(200)
+}
+
+// @Summary	Delete station
+// @Accept		json
+// @Produce	json
+// @Tags		stations
+// @Param		id	path	int	true	"Station ID"	Format(uint64)
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/stations/{id} [delete]
+func (r *StationRouter) delete(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+
+	err = r.uc.Delete(context.TODO(), uint32(idUint))
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(200)
+}
+
+// @Summary	Get station
+// @Accept		json
+// @Produce	json
+// @Tags		stations
+// @Param		id	path	int	true	"Station ID"	Format(uint64)
+// @Success	200	{object}	biz.Stations
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/stations/{id} [get]
+func (r *StationRouter) getById(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+
+	station, err := r.uc.GetById(context.TODO(), uint32(idUint))
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, station)
+}
+
+type ListStations struct {
+	Stations []*biz.Stations
+	Count    int64
+}
+
+// @Summary	List stations
+// @Accept		json
+// @Produce	json
+// @Tags		stations
+// @Success	200	{object}	route.ListStations
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/stations/ [get]
+func (r *StationRouter) list(c *gin.Context) {
+	stations, total, err := r.uc.List(context.TODO())
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListStations{
+		Stations: stations,
+		Count:    total,
+	})
+}
+
+type ListDepartures struct {
+	Departures []*biz.Departure `json:"departures"`
+}
+
+// @Summary	Departures from station
+// @Description	Ближайшие отправления по расписаниям всех маршрутов остановки
+// @Accept		json
+// @Produce	json
+// @Tags		stations
+// @Param		id		path	int		true	"Station ID"	Format(uint64)
+// @Param		at		query	string	false	"RFC3339, по умолчанию сейчас"
+// @Param		limit	query	int		false	"по умолчанию 10"
+// @Success	200	{object}	route.ListDepartures
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/stations/{id}/departures [get]
+func (r *StationRouter) departures(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	at := time.Now()
+	if value := c.Query("at"); value != "" {
+		at, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.AbortWithStatusJSON(400, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+	}
+	limit := 10
+	if value := c.Query("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil {
+			c.AbortWithStatusJSON(400, gin.H{
+				"error": "parse limit error",
+			})
+			return
+		}
+	}
+	departures, err := r.ucT.Departures(context.TODO(), uint32(idUint), at, limit)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListDepartures{
+		Departures: departures,
+	})
+}
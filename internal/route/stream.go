@@ -0,0 +1,73 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const streamKeepAlive = 15 * time.Second
+
+type StreamRouter struct {
+	stream *biz.FleetStream
+}
+
+func NewStreamRouter(stream *biz.FleetStream) *StreamRouter {
+	return &StreamRouter{stream: stream}
+}
+
+func (r *StreamRouter) Register(router *gin.RouterGroup) {
+	router.GET("/", r.subscribe)
+}
+
+// @Summary	Поток событий автопарка (SSE)
+// @Description	События bus.status, bus.position и accident. Токен можно передать в access_token.
+// @Produce	text/event-stream
+// @Tags		stream
+// @Param		route			query	[]int	false	"Route ID"	collectionFormat(multi)
+// @Param		access_token	query	string	false	"Keycloak access token"
+// @Success	200	{object}	biz.FleetEvent
+// @Failure	401
+// @Failure	400
+// @Router		/stream/ [get]
+func (r *StreamRouter) subscribe(c *gin.Context) {
+	routeIDs := make([]uint32, 0)
+	for _, param := range c.QueryArray("route") {
+		for _, value := range strings.Split(param, ",") {
+			id, err := strconv.Atoi(value)
+			if err != nil {
+				c.AbortWithStatusJSON(400, gin.H{
+					"error": "parse route id error",
+				})
+				return
+			}
+			routeIDs = append(routeIDs, uint32(id))
+		}
+	}
+
+	sub := r.stream.Subscribe(routeIDs)
+	defer r.stream.Unsubscribe(sub)
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-sub.C:
+			if !ok {
+				return false
+			}
+			c.SSEvent(event.Type, event)
+		case <-keepAlive.C:
+			c.SSEvent("ping", time.Now().Unix())
+		}
+		return true
+	})
+}
@@ -0,0 +1,286 @@
+package route
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type TimetableRouter struct {
+	uc *biz.TimetableUseCase
+	v  *validator.Validate
+}
+
+func NewTimetableRouter(uc *biz.TimetableUseCase) *TimetableRouter {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	return &TimetableRouter{uc: uc, v: validate}
+}
+
+func (r *TimetableRouter) Register(router *gin.RouterGroup) {
+	router.POST("/", r.create)
+	router.GET("/", r.list)
+	router.GET("/:id", r.getById)
+	router.PUT("/:id", r.update)
+	router.DELETE("/:id", r.delete)
+	router.GET("/:id/trips", r.trips)
+}
+
+// TimetableDTO время передается в формате HH:MM или HH:MM:SS, Headway и Dwell в секундах
+type TimetableDTO struct {
+	RouteID        uint32 `validate:"required"`
+	Name           string
+	ServiceDays    []int `validate:"required"`
+	FirstDeparture *biz.ClockTime
+	LastDeparture  *biz.ClockTime
+	Headway        uint32
+	Trips          []biz.ClockTime
+	Dwell          uint32
+}
+
+type ListTimetables struct {
+	Timetables []*biz.Timetable `json:"timetables"`
+}
+
+type ListTrips struct {
+	Trips []*biz.Trip `json:"trips"`
+}
+
+func (r *TimetableRouter) parseDTO(c *gin.Context) (*biz.Timetable, bool) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+	dto := TimetableDTO{}
+	err = json.Unmarshal(body, &dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+	err = r.v.Struct(dto)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return nil, false
+	}
+	return &biz.Timetable{
+		RouteID:        dto.RouteID,
+		Name:           dto.Name,
+		ServiceDays:    dto.ServiceDays,
+		FirstDeparture: dto.FirstDeparture,
+		LastDeparture:  dto.LastDeparture,
+		Headway:        dto.Headway,
+		Trips:          dto.Trips,
+		Dwell:          dto.Dwell,
+	}, true
+}
+
+// @Summary	Create timetable
+// @Accept		json
+// @Produce	json
+// @Tags		timetables
+// @Param		dto	body	route.TimetableDTO	true	"dto"
+// @Success	200	{object}	biz.Timetable
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/timetables/ [post]
+func (r *TimetableRouter) create(c *gin.Context) {
+	timetable, ok := r.parseDTO(c)
+	if !ok {
+		return
+	}
+	err := r.uc.Create(context.TODO(), timetable)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, timetable)
+}
+
+// @Summary	Update timetable
+// @Accept		json
+// @Produce	json
+// @Tags		timetables
+// @Param		id	path	int	true	"Timetable ID"	Format(uint64)
+// @Param		dto	body	route.TimetableDTO	true	"dto"
+// @Success	200	{object}	biz.Timetable
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/timetables/{id} [put]
+func (r *TimetableRouter) update(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	timetable, ok := r.parseDTO(c)
+	if !ok {
+		return
+	}
+	timetable.Id = uint32(idUint)
+	err = r.uc.Update(context.TODO(), timetable)
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, timetable)
+}
+
+// @Summary	Delete timetable
+// @Accept		json
+// @Produce	json
+// @Tags		timetables
+// @Param		id	path	int	true	"Timetable ID"	Format(uint64)
+// @Success	200
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/timetables/{id} [delete]
+func (r *TimetableRouter) delete(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	err = r.uc.Delete(context.TODO(), uint32(idUint))
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.Status(200)
+}
+
+// @Summary	Get timetable
+// @Accept		json
+// @Produce	json
+// @Tags		timetables
+// @Param		id	path	int	true	"Timetable ID"	Format(uint64)
+// @Success	200	{object}	biz.Timetable
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/timetables/{id} [get]
+func (r *TimetableRouter) getById(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	timetable, err := r.uc.GetById(context.TODO(), uint32(idUint))
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, timetable)
+}
+
+// @Summary	List timetables
+// @Accept		json
+// @Produce	json
+// @Tags		timetables
+// @Param		route	query	int	false	"Route ID"
+// @Success	200	{object}	route.ListTimetables
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/timetables/ [get]
+func (r *TimetableRouter) list(c *gin.Context) {
+	routeIDs := make([]uint32, 0)
+	if routeQuery := c.Query("route"); routeQuery != "" {
+		routeID, err := strconv.Atoi(routeQuery)
+		if err != nil {
+			c.AbortWithStatusJSON(400, gin.H{
+				"error": "parse route error",
+			})
+			return
+		}
+		routeIDs = append(routeIDs, uint32(routeID))
+	}
+	timetables, err := r.uc.List(context.TODO(), routeIDs)
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListTimetables{
+		Timetables: timetables,
+	})
+}
+
+// @Summary	Trips of timetable
+// @Description	Рейсы расписания с плановым временем на каждой остановке маршрута
+// @Accept		json
+// @Produce	json
+// @Tags		timetables
+// @Param		id	path	int	true	"Timetable ID"	Format(uint64)
+// @Success	200	{object}	route.ListTrips
+// @Failure	401
+// @Failure	403
+// @Failure	500
+// @Failure	400
+// @Failure	404
+// @Router		/timetables/{id}/trips [get]
+func (r *TimetableRouter) trips(c *gin.Context) {
+	id := c.Param("id")
+	idUint, err := strconv.Atoi(id)
+
+	if err != nil {
+		c.AbortWithStatusJSON(400, gin.H{
+			"error": "parse id error",
+		})
+		return
+	}
+	trips, err := r.uc.Trips(context.TODO(), uint32(idUint))
+	if err != nil {
+		c.AbortWithStatusJSON(400, &gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	c.JSON(200, &ListTrips{
+		Trips: trips,
+	})
+}
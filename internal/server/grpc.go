@@ -1,18 +1,96 @@
 package server
 
 import (
+	busV1 "bus-service/api/bus/v1"
+	routeV1 "bus-service/api/route/v1"
+	stationV1 "bus-service/api/station/v1"
 	"bus-service/internal/conf"
+	"bus-service/internal/data"
+	"bus-service/internal/service"
+	"context"
+	"strings"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
+// grpcAuth проверяет Bearer токен, если он передан, и кладет subject в контекст.
+// Методы, которым нужен пользователь, проверяют его сами
+func grpcAuth(api *data.KeycloakAPI) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			authHeader := tr.RequestHeader().Get("authorization")
+			if authHeader == "" {
+				return handler(ctx, req)
+			}
+			authParts := strings.Split(authHeader, " ")
+			if len(authParts) != 2 || authParts[0] != "Bearer" {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", "not token")
+			}
+			rptResult, err := api.CheckToken(authParts[1])
+			if err != nil {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", err.Error())
+			}
+			if rptResult.Active == nil || !*rptResult.Active {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", "token expired")
+			}
+			user, err := api.GetUserInfo(authParts[1])
+			if err != nil {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", err.Error())
+			}
+			if user.Sub == nil {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", "not token")
+			}
+			return handler(service.NewSubjectContext(ctx, *user.Sub), req)
+		}
+	}
+}
+
+// grpcStatusRole пускает смену статуса автобуса только диспетчеру, как PUT /bus/:id/status
+func grpcStatusRole(api *data.KeycloakAPI) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			update, ok := req.(*busV1.UpdateBusRequest)
+			if !ok || update.Status == "" {
+				return handler(ctx, req)
+			}
+			subject, ok := service.SubjectFromContext(ctx)
+			if !ok {
+				return nil, kerrors.Unauthorized("UNAUTHORIZED", "status change requires a token")
+			}
+			allowed, err := api.HasRealmRole(subject, dispatcherRole)
+			if err != nil {
+				return nil, kerrors.InternalServer("ROLE_CHECK_FAILED", err.Error())
+			}
+			if !allowed {
+				return nil, kerrors.Forbidden("FORBIDDEN", "forbidden")
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, logger log.Logger) *grpc.Server {
+func NewGRPCServer(
+	c *conf.Server,
+	bus *service.BusService,
+	route *service.RouteService,
+	station *service.StationService,
+	keycloak *data.KeycloakAPI,
+	logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			grpcAuth(keycloak),
+			grpcStatusRole(keycloak),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -25,6 +103,8 @@ func NewGRPCServer(c *conf.Server, logger log.Logger) *grpc.Server {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
-	// v1.RegisterGreeterServer(srv, greeter)
+	busV1.RegisterBusServer(srv, bus)
+	routeV1.RegisterRouteServer(srv, route)
+	stationV1.RegisterStationServer(srv, station)
 	return srv
 }
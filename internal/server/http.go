@@ -2,12 +2,14 @@ package server
 
 import (
 	_ "bus-service/docs"
+	"bus-service/internal/biz"
 	"bus-service/internal/conf"
 	"bus-service/internal/data"
 	"bus-service/internal/route"
 	http1 "net/http"
 	"strings"
 
+	"github.com/Nerzal/gocloak/v13"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-kratos/kratos/v2/log"
@@ -35,38 +37,109 @@ func AuthMiddleware(api *data.KeycloakAPI) gin.HandlerFunc {
 			c.Abort()
 			return
 		}
-		accessToken := authParts[1]
+		authorize(c, api, authParts[1])
+	}
+}
 
-		rptResult, err := api.CheckToken(accessToken)
-		if err != nil {
-			c.JSON(http1.StatusUnauthorized, &gin.H{
-				"error": err.Error(),
+// accessTokenKey ключ gin.Context для токена из параметра access_token
+const accessTokenKey = "access_token"
+
+// stripAccessToken убирает access_token из URL, чтобы токен не попал в лог gin.
+// Подключается до логгера, токен передается дальше через контекст
+func stripAccessToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := c.Request.URL.Query()
+		token := query.Get("access_token")
+		if token == "" {
+			return
+		}
+		c.Set(accessTokenKey, token)
+		query.Del("access_token")
+		c.Request.URL.RawQuery = query.Encode()
+	}
+}
+
+// StreamAuthMiddleware дополнительно принимает токен в параметре access_token,
+// так как EventSource в браузере не умеет передавать заголовки
+func StreamAuthMiddleware(api *data.KeycloakAPI) gin.HandlerFunc {
+	auth := AuthMiddleware(api)
+	return func(c *gin.Context) {
+		if token := c.GetString(accessTokenKey); token != "" && c.Request.Header.Get("Authorization") == "" {
+			authorize(c, api, token)
+			return
+		}
+		auth(c)
+	}
+}
+
+// dispatcherRole роль realm для административных операций
+const dispatcherRole = biz.DispatcherRole
+
+// RoleMiddleware пропускает только пользователей с ролью roleName,
+// подключается после AuthMiddleware
+func RoleMiddleware(api *data.KeycloakAPI, roleName string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userD, ok := c.Get("user")
+		if !ok {
+			c.AbortWithStatusJSON(http1.StatusUnauthorized, &gin.H{
+				"error": "not token",
 			})
-			c.Abort()
 			return
 		}
-		istokenvalid := *rptResult.Active
-		if !istokenvalid {
-			c.JSON(http1.StatusUnauthorized, &gin.H{
-				"error": "token expired",
+		user, ok := userD.(*gocloak.UserInfo)
+		if !ok || user.Sub == nil {
+			c.AbortWithStatusJSON(http1.StatusUnauthorized, &gin.H{
+				"error": "not token",
 			})
-			c.Abort()
 			return
 		}
-		user, err := api.GetUserInfo(accessToken)
-
+		allowed, err := api.HasRealmRole(*user.Sub, roleName)
 		if err != nil {
-			c.JSON(http1.StatusUnauthorized, &gin.H{
+			c.AbortWithStatusJSON(http1.StatusInternalServerError, &gin.H{
 				"error": err.Error(),
 			})
-			c.Abort()
 			return
 		}
-		c.Set("user", user)
+		if !allowed {
+			c.AbortWithStatusJSON(http1.StatusForbidden, &gin.H{
+				"error": "forbidden",
+			})
+			return
+		}
 		c.Next()
 	}
 }
 
+func authorize(c *gin.Context, api *data.KeycloakAPI, accessToken string) {
+	rptResult, err := api.CheckToken(accessToken)
+	if err != nil {
+		c.JSON(http1.StatusUnauthorized, &gin.H{
+			"error": err.Error(),
+		})
+		c.Abort()
+		return
+	}
+	istokenvalid := *rptResult.Active
+	if !istokenvalid {
+		c.JSON(http1.StatusUnauthorized, &gin.H{
+			"error": "token expired",
+		})
+		c.Abort()
+		return
+	}
+	user, err := api.GetUserInfo(accessToken)
+
+	if err != nil {
+		c.JSON(http1.StatusUnauthorized, &gin.H{
+			"error": err.Error(),
+		})
+		c.Abort()
+		return
+	}
+	c.Set("user", user)
+	c.Next()
+}
+
 //	@title			Bus Service Swagger API
 //	@version		1.0
 //	@description	This is documentation api for backend
@@ -87,6 +160,16 @@ func NewHTTPServer(
 	keycloak *data.KeycloakAPI,
 	route *route.RouteRouter,
 	driver *route.DriverRoute,
+	stations *route.StationRouter,
+	position *route.PositionRouter,
+	stream *route.StreamRouter,
+	battery *route.BatteryRouter,
+	roster *route.RosterRouter,
+	timetable *route.TimetableRouter,
+	gtfs *route.GtfsRouter,
+	accident *route.AccidentRouter,
+	health *route.HealthRouter,
+	deadLetter *route.DeadLetterRouter,
 	logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
@@ -102,23 +185,60 @@ func NewHTTPServer(
 	if c.Http.Timeout != nil {
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
-	r := gin.Default()
+	r := gin.New()
+	r.Use(stripAccessToken(), gin.Logger(), gin.Recovery())
 	config := cors.DefaultConfig()
 	config.AllowOrigins = []string{"*"}
-	config.AllowMethods = []string{"POST", "OPTIONS", "GET", "PUT", "DELETE"}
+	config.AllowMethods = []string{"POST", "OPTIONS", "GET", "PUT", "PATCH", "DELETE"}
 	config.AllowHeaders = []string{"Content-Type", "Content-Length", "Accept-Encoding", "X-CSRF-Token", "Authorization", "accept", "origin", "Cache-Control", "X-Requested-With"}
 	config.AllowCredentials = true
 	r.Use(cors.New(config))
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	health.Register(r.Group("/health"))
 	busG := r.Group("/bus")
 	busG.Use(AuthMiddleware(keycloak))
 	bus.Register(busG)
+	busAdminG := busG.Group("/")
+	busAdminG.Use(RoleMiddleware(keycloak, dispatcherRole))
+	bus.RegisterAdmin(busAdminG)
+	position.Register(busG)
+	battery.Register(busG)
 	routeG := r.Group("/route")
 	routeG.Use(AuthMiddleware(keycloak))
 	route.Register(routeG)
 	routeDriver := r.Group("/drivers")
 	routeDriver.Use(AuthMiddleware(keycloak))
 	driver.Register(routeDriver)
+	driverAdminG := routeDriver.Group("/")
+	driverAdminG.Use(RoleMiddleware(keycloak, dispatcherRole))
+	driver.RegisterAdmin(driverAdminG)
+	stationsG := r.Group("/stations")
+	stationsG.Use(AuthMiddleware(keycloak))
+	stations.Register(stationsG)
+	rosterG := r.Group("/roster")
+	rosterG.Use(AuthMiddleware(keycloak), RoleMiddleware(keycloak, dispatcherRole))
+	roster.Register(rosterG)
+	timetableG := r.Group("/timetables")
+	timetableG.Use(AuthMiddleware(keycloak))
+	timetable.Register(timetableG)
+	accidentG := r.Group("/accidents")
+	accidentG.Use(AuthMiddleware(keycloak))
+	accident.Register(accidentG)
+	accidentAdminG := accidentG.Group("/")
+	accidentAdminG.Use(RoleMiddleware(keycloak, dispatcherRole))
+	accident.RegisterAdmin(accidentAdminG)
+	// фиды GTFS открытые данные, без авторизации
+	gtfsG := r.Group("/gtfs")
+	gtfs.Register(gtfsG)
+	gtfsAdminG := gtfsG.Group("/")
+	gtfsAdminG.Use(AuthMiddleware(keycloak), RoleMiddleware(keycloak, dispatcherRole))
+	gtfs.RegisterAdmin(gtfsAdminG)
+	deadLetterG := r.Group("/dead-letters")
+	deadLetterG.Use(AuthMiddleware(keycloak), RoleMiddleware(keycloak, dispatcherRole))
+	deadLetter.Register(deadLetterG)
+	streamG := r.Group("/stream")
+	streamG.Use(StreamAuthMiddleware(keycloak))
+	stream.Register(streamG)
 	srv := http.NewServer(opts...)
 
 	srv.HandlePrefix("/", r)
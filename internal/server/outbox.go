@@ -0,0 +1,79 @@
+package server
+
+import (
+	"bus-service/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// outboxInterval пауза между проверками outbox, когда отправлять нечего
+	outboxInterval = time.Second
+	outboxBatch    = 100
+	// outboxRetention сколько хранятся отправленные сообщения
+	outboxRetention = 7 * 24 * time.Hour
+)
+
+// OutboxRelay отправляет в RabbitMQ сообщения, записанные в outbox
+type OutboxRelay struct {
+	outbox *biz.Outbox
+	rabbit *biz.RabbitData
+	logger *log.Helper
+	stop   chan struct{}
+}
+
+func NewOutboxRelay(outbox *biz.Outbox, rabbit *biz.RabbitData, logger log.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		outbox: outbox,
+		rabbit: rabbit,
+		logger: log.NewHelper(logger),
+		stop:   make(chan struct{}),
+	}
+}
+
+func (r *OutboxRelay) Start(ctx context.Context) error {
+	ticker := time.NewTicker(outboxInterval)
+	defer ticker.Stop()
+	purged := time.Time{}
+	for {
+		select {
+		case <-ticker.C:
+			r.relay(ctx)
+			if time.Since(purged) > time.Hour {
+				purged = time.Now()
+				if _, err := r.outbox.Purge(ctx, purged.Add(-outboxRetention)); err != nil {
+					r.logger.Errorf("outbox purge: %s", err)
+				}
+			}
+		case <-r.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// relay отправляет пачки, пока outbox не опустеет. Пока RabbitMQ недоступен,
+// сообщения ждут в outbox
+func (r *OutboxRelay) relay(ctx context.Context) {
+	if !r.rabbit.Conn.State().Connected {
+		return
+	}
+	for {
+		sent, err := r.outbox.Relay(ctx, outboxBatch)
+		if err != nil {
+			r.logger.Errorf("outbox relay: %s", err)
+			return
+		}
+		if sent < outboxBatch {
+			return
+		}
+	}
+}
+
+func (r *OutboxRelay) Stop(ctx context.Context) error {
+	close(r.stop)
+	return nil
+}
@@ -5,33 +5,39 @@ import (
 	"bus-service/pkg/rabbit"
 	"context"
 	"encoding/json"
-	"log"
-)
+	"errors"
 
-func NewRabbitConn(ch *biz.RabbitData, uc *biz.RouteUseCase) *rabbit.RabbitConn {
+	amqp "github.com/rabbitmq/amqp091-go"
+	"gorm.io/gorm"
+)
 
-	msgs, err := ch.Ch.Consume(
-		"accident",
-		"",
-		true,
-		false,
-		false,
-		false,
-		nil,
-	)
-	if err != nil {
-		log.Fatalf("Не удалось зарегистрировать consumer: %s", err)
-	}
+func NewRabbitConn(ch *biz.RabbitData, uc *biz.AccidentUseCase, ucP *biz.PositionUseCase) *rabbit.RabbitConn {
+	// некорректный JSON сразу уходит в dlq, ошибки сохранения повторяются
+	ch.Conn.Consume("accident", func(ctx context.Context, d amqp.Delivery) error {
+		var accident biz.Accident
+		if err := json.Unmarshal(d.Body, &accident); err != nil {
+			return rabbit.Permanent(err)
+		}
+		err := uc.Receive(ctx, &accident)
+		// неверные даты в сообщении повтор не исправит
+		if errors.Is(err, biz.ErrInvalidAccidentDates) {
+			return rabbit.Permanent(err)
+		}
+		return err
+	})
 
-	go func() {
-		for d := range msgs {
-			var accident biz.Accident
-			err := json.Unmarshal(d.Body, &accident)
-			if err == nil {
-				uc.NewAccident(context.TODO(), &accident)
-			}
+	ch.Conn.Consume("telemetry", func(ctx context.Context, d amqp.Delivery) error {
+		var position biz.BusPosition
+		if err := json.Unmarshal(d.Body, &position); err != nil {
+			return rabbit.Permanent(err)
+		}
+		err := ucP.Report(ctx, &position)
+		// отметки с неверными координатами или неизвестного автобуса не повторяются
+		if errors.Is(err, biz.ErrInvalidPosition) || errors.Is(err, gorm.ErrRecordNotFound) {
+			return rabbit.Permanent(err)
 		}
-	}()
+		return err
+	})
 
-	return rabbit.NewRabbitConn(ch.Conn, ch.Ch)
+	return ch.Conn
 }
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"bus-service/internal/biz"
+	"bus-service/internal/conf"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// RegulationWatcher периодически проверяет открытые смены на превышение длительности
+type RegulationWatcher struct {
+	uc       *biz.ShiftUseCase
+	interval time.Duration
+	logger   *log.Helper
+	stop     chan struct{}
+}
+
+func NewRegulationWatcher(c *conf.Data, uc *biz.ShiftUseCase, logger log.Logger) *RegulationWatcher {
+	interval := c.GetRegulation().GetCheckInterval().AsDuration()
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &RegulationWatcher{
+		uc:       uc,
+		interval: interval,
+		logger:   log.NewHelper(logger),
+		stop:     make(chan struct{}),
+	}
+}
+
+func (w *RegulationWatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := w.uc.CheckOverruns(ctx); err != nil {
+				w.logger.Errorf("regulation check: %s", err)
+			}
+		case <-w.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (w *RegulationWatcher) Stop(ctx context.Context) error {
+	close(w.stop)
+	return nil
+}
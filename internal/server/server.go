@@ -5,4 +5,4 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRabbitConn, NewCustomHttp)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRabbitConn, NewCustomHttp, NewRegulationWatcher, NewOutboxRelay)
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "bus-service/api/bus/v1"
+	"bus-service/internal/biz"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+)
+
+type BusService struct {
+	pb.UnimplementedBusServer
+
+	uc *biz.BusUseCase
+}
+
+func NewBusService(uc *biz.BusUseCase) *BusService {
+	return &BusService{uc: uc}
+}
+
+func (s *BusService) CreateBus(ctx context.Context, req *pb.CreateBusRequest) (*pb.CreateBusReply, error) {
+	dto := &biz.BusDTO{
+		RouteID: req.RouteId,
+		Number:  req.Number,
+	}
+	if err := s.uc.Create(ctx, dto); err != nil {
+		return nil, convertError(err)
+	}
+	bus, err := s.uc.GetById(ctx, dto.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.CreateBusReply{Bus: busToProto(bus)}, nil
+}
+
+func (s *BusService) UpdateBus(ctx context.Context, req *pb.UpdateBusRequest) (*pb.UpdateBusReply, error) {
+	if err := s.uc.Update(ctx, &biz.BusDTO{
+		Id:      req.Id,
+		RouteID: req.RouteId,
+		Number:  req.Number,
+	}); err != nil {
+		return nil, convertError(err)
+	}
+	bus, err := s.uc.GetById(ctx, req.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if req.Status != "" && biz.BusStatus(req.Status) != bus.Status {
+		status, err := biz.ParseBusStatus(req.Status)
+		if err != nil {
+			return nil, kerrors.BadRequest("UNKNOWN_STATUS", err.Error())
+		}
+		subject, ok := SubjectFromContext(ctx)
+		if !ok {
+			return nil, kerrors.Unauthorized("UNAUTHORIZED", "status change requires a token")
+		}
+		if err := s.uc.SetStatus(ctx, req.Id, status, subject); err != nil {
+			return nil, convertError(err)
+		}
+		if bus, err = s.uc.GetById(ctx, req.Id); err != nil {
+			return nil, convertError(err)
+		}
+	}
+	return &pb.UpdateBusReply{Bus: busToProto(bus)}, nil
+}
+
+func (s *BusService) DeleteBus(ctx context.Context, req *pb.DeleteBusRequest) (*pb.DeleteBusReply, error) {
+	if err := s.uc.Delete(ctx, req.Id); err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.DeleteBusReply{}, nil
+}
+
+func (s *BusService) GetBus(ctx context.Context, req *pb.GetBusRequest) (*pb.GetBusReply, error) {
+	bus, err := s.uc.GetById(ctx, req.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.GetBusReply{Bus: busToProto(bus)}, nil
+}
+
+func (s *BusService) ListBus(ctx context.Context, req *pb.ListBusRequest) (*pb.ListBusReply, error) {
+	buses, count, err := s.uc.List(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	reply := &pb.ListBusReply{Buses: make([]*pb.BusInfo, 0, len(buses)), Count: count}
+	for _, bus := range buses {
+		reply.Buses = append(reply.Buses, busToProto(bus))
+	}
+	return reply, nil
+}
+
+func busToProto(b *biz.Bus) *pb.BusInfo {
+	info := &pb.BusInfo{
+		Id:           b.Id,
+		RouteId:      b.RouteID,
+		Number:       b.Number,
+		Status:       string(b.Status),
+		BatteryLevel: uint32(b.BatteryLevel),
+	}
+	if b.Route != nil {
+		info.Route = &pb.BusRoute{
+			Id:     b.Route.Id,
+			Number: b.Route.Number,
+			Length: b.Route.Length,
+		}
+	}
+	if b.Driver.Id != nil {
+		info.Driver = &pb.BusUser{
+			Id:        deref(b.Driver.Id),
+			Username:  deref(b.Driver.Username),
+			FirstName: deref(b.Driver.FirstName),
+			LastName:  deref(b.Driver.LastName),
+			Email:     deref(b.Driver.Email),
+		}
+	}
+	return info
+}
+
+// convertError переводит ошибки хранилища в коды gRPC
+func convertError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return kerrors.NotFound("NOT_FOUND", err.Error())
+	}
+	if errors.Is(err, biz.ErrBusInUse) {
+		return kerrors.Conflict(biz.ErrBusInUse.Error(), err.Error())
+	}
+	if errors.Is(err, biz.ErrInvalidStatusTransition) {
+		return kerrors.Conflict(biz.ErrInvalidStatusTransition.Error(), err.Error())
+	}
+	if errors.Is(err, biz.ErrBusHasDriver) {
+		return kerrors.Conflict(biz.ErrBusHasDriver.Error(), err.Error())
+	}
+	return err
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	pb "bus-service/api/route/v1"
+	"bus-service/internal/biz"
+)
+
+type RouteService struct {
+	pb.UnimplementedRouteServer
+
+	uc *biz.RouteUseCase
+}
+
+func NewRouteService(uc *biz.RouteUseCase) *RouteService {
+	return &RouteService{uc: uc}
+}
+
+func (s *RouteService) CreateRoute(ctx context.Context, req *pb.CreateRouteRequest) (*pb.CreateRouteReply, error) {
+	route := &biz.Route{
+		Number:   req.Number,
+		Stations: stationsFromProto(req.Stations),
+	}
+	if err := s.uc.Plan(ctx, route); err != nil {
+		return nil, convertError(err)
+	}
+	if err := s.uc.Create(ctx, route); err != nil {
+		return nil, convertError(err)
+	}
+	created, err := s.uc.GetById(ctx, route.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.CreateRouteReply{Route: routeToProto(created)}, nil
+}
+
+func (s *RouteService) UpdateRoute(ctx context.Context, req *pb.UpdateRouteRequest) (*pb.UpdateRouteReply, error) {
+	route := &biz.Route{
+		Id:       req.Id,
+		Number:   req.Number,
+		Stations: stationsFromProto(req.Stations),
+	}
+	if err := s.uc.Update(ctx, route); err != nil {
+		return nil, convertError(err)
+	}
+	updated, err := s.uc.GetById(ctx, req.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.UpdateRouteReply{Route: routeToProto(updated)}, nil
+}
+
+func (s *RouteService) DeleteRoute(ctx context.Context, req *pb.DeleteRouteRequest) (*pb.DeleteRouteReply, error) {
+	if err := s.uc.Delete(ctx, req.Id); err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.DeleteRouteReply{}, nil
+}
+
+func (s *RouteService) GetRoute(ctx context.Context, req *pb.GetRouteRequest) (*pb.GetRouteReply, error) {
+	route, err := s.uc.GetById(ctx, req.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.GetRouteReply{Route: routeToProto(route)}, nil
+}
+
+func (s *RouteService) ListRoute(ctx context.Context, req *pb.ListRouteRequest) (*pb.ListRouteReply, error) {
+	routes, count, err := s.uc.List(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	reply := &pb.ListRouteReply{Routes: make([]*pb.RouteInfo, 0, len(routes)), Count: count}
+	for _, route := range routes {
+		reply.Routes = append(reply.Routes, routeToProto(route))
+	}
+	return reply, nil
+}
+
+func stationsFromProto(stations []*pb.RouteStation) []biz.Stations {
+	result := make([]biz.Stations, 0, len(stations))
+	for _, station := range stations {
+		result = append(result, biz.Stations{
+			ID:   uint(station.Id),
+			Name: station.Name,
+			Lat:  station.Lat,
+			Lon:  station.Lon,
+		})
+	}
+	return result
+}
+
+func routeToProto(r *biz.Route) *pb.RouteInfo {
+	info := &pb.RouteInfo{
+		Id:       r.Id,
+		Number:   r.Number,
+		Path:     r.Path,
+		Time:     r.Time,
+		Lengths:  r.Lengths,
+		Length:   r.Length,
+		Stations: make([]*pb.RouteStation, 0, len(r.Stations)),
+	}
+	for _, station := range r.Stations {
+		info.Stations = append(info.Stations, &pb.RouteStation{
+			Id:   uint32(station.ID),
+			Name: station.Name,
+			Lat:  station.Lat,
+			Lon:  station.Lon,
+		})
+	}
+	return info
+}
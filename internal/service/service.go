@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/wire"
+)
+
+// ProviderSet is service providers.
+var ProviderSet = wire.NewSet(NewBusService, NewRouteService, NewStationService)
+
+type subjectKey struct{}
+
+// NewSubjectContext сохраняет в контексте subject пользователя из токена
+func NewSubjectContext(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// SubjectFromContext subject пользователя, вызвавшего метод
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(string)
+	return subject, ok && subject != ""
+}
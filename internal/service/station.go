@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	pb "bus-service/api/station/v1"
+	"bus-service/internal/biz"
+)
+
+type StationService struct {
+	pb.UnimplementedStationServer
+
+	uc *biz.StationUseCase
+}
+
+func NewStationService(uc *biz.StationUseCase) *StationService {
+	return &StationService{uc: uc}
+}
+
+func (s *StationService) CreateStation(ctx context.Context, req *pb.CreateStationRequest) (*pb.CreateStationReply, error) {
+	station := &biz.Stations{
+		Name: req.Name,
+		Lat:  req.Lat,
+		Lon:  req.Lon,
+	}
+	if err := s.uc.Create(ctx, station); err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.CreateStationReply{Station: stationToProto(station)}, nil
+}
+
+func (s *StationService) UpdateStation(ctx context.Context, req *pb.UpdateStationRequest) (*pb.UpdateStationReply, error) {
+	patch := &biz.StationsPatch{
+		ID:   uint(req.Id),
+		Name: req.Name,
+		Lat:  req.Lat,
+		Lon:  req.Lon,
+	}
+	if req.Routes != nil {
+		routes := make([]*biz.Route, 0, len(req.Routes.Ids))
+		for _, id := range req.Routes.Ids {
+			routes = append(routes, &biz.Route{Id: id})
+		}
+		patch.Routes = &routes
+	}
+	if err := s.uc.Update(ctx, patch); err != nil {
+		return nil, convertError(err)
+	}
+	station, err := s.uc.GetById(ctx, req.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.UpdateStationReply{Station: stationToProto(&station)}, nil
+}
+
+func (s *StationService) DeleteStation(ctx context.Context, req *pb.DeleteStationRequest) (*pb.DeleteStationReply, error) {
+	if err := s.uc.Delete(ctx, req.Id); err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.DeleteStationReply{}, nil
+}
+
+func (s *StationService) GetStation(ctx context.Context, req *pb.GetStationRequest) (*pb.GetStationReply, error) {
+	station, err := s.uc.GetById(ctx, req.Id)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &pb.GetStationReply{Station: stationToProto(&station)}, nil
+}
+
+func (s *StationService) ListStation(ctx context.Context, req *pb.ListStationRequest) (*pb.ListStationReply, error) {
+	stations, count, err := s.uc.List(ctx)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	reply := &pb.ListStationReply{Stations: make([]*pb.StationInfo, 0, len(stations)), Count: count}
+	for _, station := range stations {
+		reply.Stations = append(reply.Stations, stationToProto(station))
+	}
+	return reply, nil
+}
+
+func stationToProto(s *biz.Stations) *pb.StationInfo {
+	info := &pb.StationInfo{
+		Id:     uint32(s.ID),
+		Name:   s.Name,
+		Lat:    s.Lat,
+		Lon:    s.Lon,
+		Routes: make([]*pb.StationRoute, 0, len(s.Routes)),
+	}
+	for _, route := range s.Routes {
+		info.Routes = append(info.Routes, &pb.StationRoute{
+			Id:     route.Id,
+			Number: route.Number,
+		})
+	}
+	return info
+}
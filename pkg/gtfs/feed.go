@@ -0,0 +1,62 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Table файл фида: заголовок и строки в порядке записи
+type Table struct {
+	Name   string
+	Header []string
+	Rows   [][]string
+}
+
+// Feed набор файлов GTFS. Файлы пишутся в порядке добавления
+type Feed struct {
+	Tables []*Table
+}
+
+func (f *Feed) Add(name string, header ...string) *Table {
+	table := &Table{Name: name, Header: header}
+	f.Tables = append(f.Tables, table)
+	return table
+}
+
+func (t *Table) Append(row ...string) {
+	t.Rows = append(t.Rows, row)
+}
+
+// epoch фиксированное время изменения файлов в архиве, чтобы одинаковые данные
+// давали побайтно одинаковый zip
+var epoch = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (f *Feed) WriteZip(w io.Writer) error {
+	archive := zip.NewWriter(w)
+	for _, table := range f.Tables {
+		file, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     table.Name,
+			Method:   zip.Deflate,
+			Modified: epoch,
+		})
+		if err != nil {
+			return err
+		}
+		writer := csv.NewWriter(file)
+		if err := writer.Write(table.Header); err != nil {
+			return err
+		}
+		if err := writer.WriteAll(table.Rows); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// Time время GTFS в формате HH:MM:SS, часы могут быть больше 24
+func Time(seconds int) string {
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+}
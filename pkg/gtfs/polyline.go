@@ -0,0 +1,87 @@
+package gtfs
+
+import "errors"
+
+var ErrInvalidPolyline = errors.New("INVALID_POLYLINE")
+
+// Point координаты точки формы маршрута
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// DecodePolyline декодирует encoded polyline с точностью precision знаков
+// (5 для Google, 6 для Valhalla)
+func DecodePolyline(encoded string, precision int) ([]Point, error) {
+	factor := 1.0
+	for i := 0; i < precision; i++ {
+		factor *= 10
+	}
+	points := make([]Point, 0)
+	var lat, lon int64
+	for i := 0; i < len(encoded); {
+		var deltas [2]int64
+		for k := range deltas {
+			var result int64
+			var shift uint
+			for {
+				if i >= len(encoded) {
+					return nil, ErrInvalidPolyline
+				}
+				b := int64(encoded[i]) - 63
+				i++
+				if b < 0 {
+					return nil, ErrInvalidPolyline
+				}
+				result |= (b & 0x1f) << shift
+				shift += 5
+				if b < 0x20 {
+					break
+				}
+			}
+			if result&1 != 0 {
+				deltas[k] = ^(result >> 1)
+			} else {
+				deltas[k] = result >> 1
+			}
+		}
+		lat += deltas[0]
+		lon += deltas[1]
+		points = append(points, Point{Lat: float64(lat) / factor, Lon: float64(lon) / factor})
+	}
+	return points, nil
+}
+
+// EncodePolyline обратная к DecodePolyline операция
+func EncodePolyline(points []Point, precision int) string {
+	factor := 1.0
+	for i := 0; i < precision; i++ {
+		factor *= 10
+	}
+	encoded := make([]byte, 0, len(points)*8)
+	var prevLat, prevLon int64
+	for _, p := range points {
+		lat := round(p.Lat * factor)
+		lon := round(p.Lon * factor)
+		for _, delta := range [2]int64{lat - prevLat, lon - prevLon} {
+			value := delta << 1
+			if delta < 0 {
+				value = ^value
+			}
+			for value >= 0x20 {
+				encoded = append(encoded, byte((0x20|(value&0x1f))+63))
+				value >>= 5
+			}
+			encoded = append(encoded, byte(value+63))
+		}
+		prevLat, prevLon = lat, lon
+	}
+	return string(encoded)
+}
+
+func round(v float64) int64 {
+	if v < 0 {
+		return int64(v - 0.5)
+	}
+	return int64(v + 0.5)
+}
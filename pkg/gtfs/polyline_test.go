@@ -0,0 +1,64 @@
+package gtfs
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestPolylineRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		points    []Point
+		precision int
+	}{
+		{"empty", []Point{}, 5},
+		{"single point", []Point{{Lat: 38.5, Lon: -120.2}}, 5},
+		{"google example", []Point{{Lat: 38.5, Lon: -120.2}, {Lat: 40.7, Lon: -120.95}, {Lat: 43.252, Lon: -126.453}}, 5},
+		{"valhalla precision", []Point{{Lat: 55.755826, Lon: 37.617299}, {Lat: 55.751244, Lon: 37.618423}}, 6},
+		{"negative deltas", []Point{{Lat: 10, Lon: 10}, {Lat: -10, Lon: -10}, {Lat: 0, Lon: 0}}, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := EncodePolyline(tt.points, tt.precision)
+			decoded, err := DecodePolyline(encoded, tt.precision)
+			if err != nil {
+				t.Fatalf("decode %q: %v", encoded, err)
+			}
+			if len(decoded) != len(tt.points) {
+				t.Fatalf("decoded %d points, want %d", len(decoded), len(tt.points))
+			}
+			eps := math.Pow(10, -float64(tt.precision))
+			for i, p := range tt.points {
+				if math.Abs(decoded[i].Lat-p.Lat) > eps || math.Abs(decoded[i].Lon-p.Lon) > eps {
+					t.Errorf("point %d = %+v, want %+v", i, decoded[i], p)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodePolylineKnown(t *testing.T) {
+	points := []Point{{Lat: 38.5, Lon: -120.2}, {Lat: 40.7, Lon: -120.95}, {Lat: 43.252, Lon: -126.453}}
+	if got, want := EncodePolyline(points, 5), "_p~iF~ps|U_ulLnnqC_mqNvxq`@"; got != want {
+		t.Errorf("EncodePolyline = %q, want %q", got, want)
+	}
+}
+
+func TestDecodePolylineInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"truncated chunk", "_p~iF~ps|"},
+		{"missing lon", "_p~iF"},
+		{"byte below range", "_p~iF~ps|U "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodePolyline(tt.encoded, 5); !errors.Is(err, ErrInvalidPolyline) {
+				t.Errorf("DecodePolyline(%q) error = %v, want %v", tt.encoded, err, ErrInvalidPolyline)
+			}
+		})
+	}
+}
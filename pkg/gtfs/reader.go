@@ -0,0 +1,105 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ReadZip читает все .txt файлы фида. Вложенные каталоги в архиве игнорируются,
+// берется только имя файла
+func ReadZip(data []byte) (*Feed, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	feed := &Feed{}
+	for _, file := range archive.File {
+		name := path.Base(file.Name)
+		if file.FileInfo().IsDir() || !strings.HasSuffix(name, ".txt") {
+			continue
+		}
+		table, err := readTable(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		table.Name = name
+		feed.Tables = append(feed.Tables, table)
+	}
+	return feed, nil
+}
+
+func readTable(file *zip.File) (*Table, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	reader := csv.NewReader(rc)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		return &Table{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return &Table{Header: header, Rows: rows}, nil
+}
+
+// Table файл по имени, nil если его нет в фиде
+func (f *Feed) Table(name string) *Table {
+	for _, table := range f.Tables {
+		if table.Name == name {
+			return table
+		}
+	}
+	return nil
+}
+
+// Records строки таблицы как словари колонка -> значение
+func (t *Table) Records() []map[string]string {
+	if t == nil {
+		return nil
+	}
+	records := make([]map[string]string, 0, len(t.Rows))
+	for _, row := range t.Rows {
+		record := make(map[string]string, len(t.Header))
+		for i, column := range t.Header {
+			if i < len(row) {
+				record[column] = strings.TrimSpace(row[i])
+			}
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// ParseTime разбирает время GTFS HH:MM:SS в секунды от начала служебных суток
+func ParseTime(value string) (int, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time %q", value)
+	}
+	seconds := 0
+	for _, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time %q", value)
+		}
+		seconds = seconds*60 + v
+	}
+	return seconds, nil
+}
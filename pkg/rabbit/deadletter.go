@@ -0,0 +1,113 @@
+package rabbit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrUnknownQueue = errors.New("UNKNOWN_QUEUE")
+
+// DeadLetter сообщение из очереди недоставленных
+type DeadLetter struct {
+	MessageId   string     `json:"message_id,omitempty"`
+	ContentType string     `json:"content_type,omitempty"`
+	Body        string     `json:"body"`
+	Error       string     `json:"error,omitempty"`
+	Attempts    int        `json:"attempts"`
+	FailedAt    *time.Time `json:"failed_at,omitempty"`
+}
+
+// Queues очереди, которые читают consumers сервиса
+func (s *RabbitConn) Queues() []string {
+	queues := make([]string, 0, len(s.consumers))
+	for _, c := range s.consumers {
+		queues = append(queues, c.queue)
+	}
+	return queues
+}
+
+func (s *RabbitConn) consumed(queue string) bool {
+	for _, c := range s.consumers {
+		if c.queue == queue {
+			return true
+		}
+	}
+	return false
+}
+
+// DeadLetters возвращает до limit первых сообщений из dlq очереди queue,
+// не удаляя их: после чтения сообщения возвращаются в dlq
+func (s *RabbitConn) DeadLetters(queue string, limit int) ([]*DeadLetter, error) {
+	if !s.consumed(queue) {
+		return nil, ErrUnknownQueue
+	}
+	ch, err := s.Channel()
+	if err != nil {
+		return nil, err
+	}
+	// неподтвержденные сообщения возвращаются в очередь при закрытии канала
+	defer ch.Close()
+	letters := make([]*DeadLetter, 0)
+	for len(letters) < limit {
+		d, ok, err := ch.Get(DeadLetterQueue(queue), false)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		letter := &DeadLetter{
+			MessageId:   d.MessageId,
+			ContentType: d.ContentType,
+			Body:        string(d.Body),
+			Attempts:    retryCount(d.Headers),
+		}
+		if reason, ok := d.Headers[headerError].(string); ok {
+			letter.Error = reason
+		}
+		if failedAt, ok := d.Headers[headerFailedAt].(time.Time); ok {
+			letter.FailedAt = &failedAt
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
+
+// Replay возвращает до limit сообщений из dlq в очередь queue со сброшенным
+// счетчиком попыток и возвращает число перенесенных сообщений
+func (s *RabbitConn) Replay(ctx context.Context, queue string, limit int) (int, error) {
+	if !s.consumed(queue) {
+		return 0, ErrUnknownQueue
+	}
+	ch, err := s.Channel()
+	if err != nil {
+		return 0, err
+	}
+	defer ch.Close()
+	if err := ch.Confirm(false); err != nil {
+		return 0, err
+	}
+	replayed := 0
+	for replayed < limit {
+		d, ok, err := ch.Get(DeadLetterQueue(queue), false)
+		if err != nil {
+			return replayed, err
+		}
+		if !ok {
+			break
+		}
+		headers := copyHeaders(d.Headers)
+		delete(headers, headerRetryCount)
+		delete(headers, headerError)
+		delete(headers, headerFailedAt)
+		if err := republish(ctx, ch, queue, d, headers); err != nil {
+			return replayed, err
+		}
+		if err := d.Ack(false); err != nil {
+			return replayed, err
+		}
+		replayed++
+	}
+	return replayed, nil
+}
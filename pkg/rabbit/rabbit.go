@@ -2,29 +2,232 @@ package rabbit
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/log"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+var ErrNotConnected = errors.New("RABBIT_NOT_CONNECTED")
+
+const (
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// Topology объявляет очереди и обменники, вызывается при каждом подключении
+type Topology func(conn *amqp.Connection) error
+
+// Handler обрабатывает одно сообщение очереди. Сообщение подтверждается, если
+// ошибки нет, иначе отправляется на повтор, а после MaxRetries попыток или
+// при ошибке Permanent уходит в очередь недоставленных <queue>.dlq
+type Handler func(ctx context.Context, d amqp.Delivery) error
+
+type consumer struct {
+	queue   string
+	handler Handler
+}
+
+// State состояние подключения для проверки здоровья сервиса
+type State struct {
+	Connected bool `json:"connected"`
+	// Since время последнего подключения или разрыва
+	Since      time.Time `json:"since"`
+	Reconnects int       `json:"reconnects"`
+	LastError  string    `json:"last_error,omitempty"`
+}
+
+// RabbitConn держит подключение к RabbitMQ: подключается при старте сервера,
+// при разрыве переподключается с нарастающей паузой, заново объявляет
+// очереди и запускает consumers
 type RabbitConn struct {
-	conn    *amqp.Connection
-	channel *amqp.Channel
+	url       string
+	topology  []Topology
+	consumers []consumer
+	logger    *log.Helper
+
+	mu    sync.RWMutex
+	conn  *amqp.Connection
+	state State
+	stop  chan struct{}
+}
+
+func NewRabbitConn(url string, logger log.Logger) *RabbitConn {
+	return &RabbitConn{
+		url:    url,
+		logger: log.NewHelper(logger),
+		stop:   make(chan struct{}),
+	}
+}
+
+// Declare добавляет объявление топологии, вызывается до Start
+func (s *RabbitConn) Declare(topology Topology) {
+	s.topology = append(s.topology, topology)
 }
 
-func NewRabbitConn(conn *amqp.Connection, channel *amqp.Channel) *RabbitConn {
-	return &RabbitConn{conn: conn, channel: channel}
+// Consume подписывает handler на очередь queue, вызывается до Start
+func (s *RabbitConn) Consume(queue string, handler Handler) {
+	s.consumers = append(s.consumers, consumer{queue: queue, handler: handler})
+}
+
+// Channel открывает новый канал на текущем подключении
+func (s *RabbitConn) Channel() (*amqp.Channel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.conn == nil || s.conn.IsClosed() {
+		return nil, ErrNotConnected
+	}
+	return s.conn.Channel()
+}
+
+func (s *RabbitConn) State() State {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.state
 }
 
 func (s *RabbitConn) Start(ctx context.Context) error {
+	backoff := minBackoff
+	for {
+		select {
+		case <-s.stop:
+			return nil
+		default:
+		}
+		closed, err := s.connect(ctx)
+		if err != nil {
+			s.disconnected(err)
+			s.logger.Errorf("rabbit connect: %s, retry in %s", err, backoff)
+			select {
+			case <-time.After(backoff):
+			case <-s.stop:
+				return nil
+			case <-ctx.Done():
+				return nil
+			}
+			if backoff *= 2; backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+		backoff = minBackoff
+		select {
+		case err := <-closed:
+			if err == nil {
+				// соединение закрыто без ошибки брокера, например при остановке consumer
+				s.disconnected(amqp.ErrClosed)
+			} else {
+				s.disconnected(err)
+			}
+			s.logger.Errorf("rabbit connection lost: %v", err)
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *RabbitConn) connect(ctx context.Context) (chan *amqp.Error, error) {
+	conn, err := amqp.Dial(s.url)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.declare(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	for _, c := range s.consumers {
+		if err := s.consume(ctx, conn, c); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	s.mu.Lock()
+	if !s.state.Since.IsZero() {
+		s.state.Reconnects++
+	}
+	s.conn = conn
+	s.state.Connected = true
+	s.state.Since = time.Now()
+	s.state.LastError = ""
+	s.mu.Unlock()
+	s.logger.Info("rabbit connected")
+	return closed, nil
+}
+
+func (s *RabbitConn) declare(conn *amqp.Connection) error {
+	for _, topology := range s.topology {
+		if err := topology(conn); err != nil {
+			return err
+		}
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+	for _, c := range s.consumers {
+		if err := declareRetry(ch, c.queue); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (s *RabbitConn) Stop(ctx context.Context) error {
-	if s.channel != nil {
-		s.channel.Close()
+// consume запускает consumer в отдельном канале. Если канал закрылся при живом
+// подключении, подключение закрывается, чтобы Start переподключился и поднял consumer заново
+func (s *RabbitConn) consume(ctx context.Context, conn *amqp.Connection, c consumer) error {
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
 	}
+	if err := ch.Qos(Prefetch, 0, false); err != nil {
+		ch.Close()
+		return err
+	}
+	// перекладывание в retry и dlq подтверждается брокером до ack исходного сообщения
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return err
+	}
+	deliveries, err := ch.Consume(c.queue, "", false, false, false, false, nil)
+	if err != nil {
+		ch.Close()
+		return err
+	}
+	go func() {
+		for d := range deliveries {
+			s.handle(ctx, ch, c, d)
+		}
+		if !conn.IsClosed() {
+			s.logger.Errorf("rabbit consumer %s stopped, reconnecting", c.queue)
+			conn.Close()
+		}
+	}()
+	return nil
+}
+
+func (s *RabbitConn) disconnected(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state.Connected {
+		s.state.Since = time.Now()
+	}
+	s.state.Connected = false
+	s.state.LastError = err.Error()
+}
+
+func (s *RabbitConn) Stop(ctx context.Context) error {
+	close(s.stop)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.conn != nil {
 		s.conn.Close()
 	}
+	s.state.Connected = false
 	return nil
 }
@@ -0,0 +1,145 @@
+package rabbit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	// MaxRetries сколько раз сообщение повторяется перед отправкой в dlq
+	MaxRetries = 5
+	// RetryDelay через сколько сообщение из retry возвращается в основную очередь
+	RetryDelay = 30 * time.Second
+	// Prefetch сколько неподтвержденных сообщений получает consumer
+	Prefetch = 10
+
+	headerRetryCount = "x-retry-count"
+	headerError      = "x-error"
+	headerFailedAt   = "x-failed-at"
+)
+
+func RetryQueue(queue string) string {
+	return queue + ".retry"
+}
+
+func DeadLetterQueue(queue string) string {
+	return queue + ".dlq"
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent помечает ошибку как неисправимую: сообщение сразу уходит в dlq без повторов
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// declareRetry объявляет для очереди queue очередь повторов, из которой сообщения
+// по истечении RetryDelay возвращаются в queue, и очередь недоставленных
+func declareRetry(ch *amqp.Channel, queue string) error {
+	_, err := ch.QueueDeclare(RetryQueue(queue), true, false, false, false, amqp.Table{
+		"x-message-ttl":             int64(RetryDelay / time.Millisecond),
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": queue,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = ch.QueueDeclare(DeadLetterQueue(queue), true, false, false, false, nil)
+	return err
+}
+
+// call вызывает обработчик, паника обработчика считается неисправимой ошибкой,
+// чтобы сообщение ушло в dlq, а не роняло сервис при каждой повторной доставке
+func (c consumer) call(ctx context.Context, d amqp.Delivery) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = Permanent(fmt.Errorf("panic: %v", r))
+		}
+	}()
+	return c.handler(ctx, d)
+}
+
+func (s *RabbitConn) handle(ctx context.Context, ch *amqp.Channel, c consumer, d amqp.Delivery) {
+	err := c.call(ctx, d)
+	if err == nil {
+		d.Ack(false)
+		return
+	}
+	attempts := retryCount(d.Headers) + 1
+	target := RetryQueue(c.queue)
+	var permanent *permanentError
+	if errors.As(err, &permanent) || attempts > MaxRetries {
+		target = DeadLetterQueue(c.queue)
+	}
+	s.logger.Errorf("rabbit %s: message %s attempt %d: %s, moving to %s", c.queue, d.MessageId, attempts, err, target)
+	headers := copyHeaders(d.Headers)
+	headers[headerRetryCount] = int32(attempts)
+	headers[headerError] = err.Error()
+	headers[headerFailedAt] = time.Now()
+	if err := republish(ctx, ch, target, d, headers); err != nil {
+		s.logger.Errorf("rabbit %s: move message %s to %s: %s", c.queue, d.MessageId, target, err)
+		// сообщение возвращается в очередь и будет обработано снова
+		d.Nack(false, true)
+		return
+	}
+	d.Ack(false)
+}
+
+// republish публикует копию сообщения в очередь queue и ждет подтверждения брокера
+func republish(ctx context.Context, ch *amqp.Channel, queue string, d amqp.Delivery, headers amqp.Table) error {
+	confirm, err := ch.PublishWithDeferredConfirmWithContext(ctx, "", queue, false, false, amqp.Publishing{
+		Headers:      headers,
+		ContentType:  d.ContentType,
+		Body:         d.Body,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    d.MessageId,
+		Timestamp:    d.Timestamp,
+	})
+	if err != nil {
+		return err
+	}
+	ack, err := confirm.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !ack {
+		return errNack
+	}
+	return nil
+}
+
+var errNack = errors.New("NACK")
+
+func retryCount(headers amqp.Table) int {
+	switch v := headers[headerRetryCount].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+func copyHeaders(headers amqp.Table) amqp.Table {
+	result := amqp.Table{}
+	for k, v := range headers {
+		result[k] = v
+	}
+	return result
+}